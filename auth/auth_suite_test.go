@@ -0,0 +1,13 @@
+package auth_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAuth(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Auth Suite")
+}
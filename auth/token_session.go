@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/uaa"
+	log "github.com/sirupsen/logrus"
+)
+
+// ExpiryMargin is how long before its expiry an access token is renewed, so that it does not expire while a
+// request to the BOSH Director is in flight.
+const ExpiryMargin = time.Minute
+
+// TokenSession provides the UAA access tokens a BOSH client authenticates with. A token is renewed before it expires,
+// or when the BOSH Director rejects it, with the refresh token it came with, if any. When there is no refresh token,
+// or when it has expired too, a new token is granted with the credentials of the session.
+type TokenSession struct {
+	uaa   uaa.UAA
+	grant func() (uaa.AccessToken, error)
+
+	mu    sync.Mutex
+	token uaa.AccessToken
+
+	expiresAt       atomic.Int64
+	refreshFailures atomic.Uint64
+}
+
+// NewClientCredentialsSession returns a TokenSession granting tokens to the UAA client of uaaClient.
+func NewClientCredentialsSession(uaaClient uaa.UAA) *TokenSession {
+	return &TokenSession{
+		uaa:   uaaClient,
+		grant: uaaClient.ClientCredentialsGrant,
+	}
+}
+
+// NewPasswordSession returns a TokenSession granting tokens to the UAA user with username and password.
+func NewPasswordSession(uaaClient uaa.UAA, username string, password string) *TokenSession {
+	answers := []uaa.PromptAnswer{
+		{
+			Key:   "username",
+			Value: username,
+		},
+		{
+			Key:   "password",
+			Value: password,
+		},
+	}
+
+	return &TokenSession{
+		uaa: uaaClient,
+		grant: func() (uaa.AccessToken, error) {
+			return uaaClient.OwnerPasswordCredentialsGrant(answers)
+		},
+	}
+}
+
+// TokenFunc returns the Authorization header value of the current access token, granting the first one on the first
+// call. The token is renewed when it is about to expire, or when retried is set because the BOSH Director rejected it.
+func (s *TokenSession) TokenFunc(retried bool) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		token, err := s.grant()
+		if err != nil {
+			return "", fmt.Errorf("error requesting UAA access token: %v", err)
+		}
+		s.setToken(token)
+	} else if retried || s.expiring() {
+		if err := s.renew(); err != nil {
+			return "", err
+		}
+	}
+
+	return s.token.Type() + " " + s.token.Value(), nil
+}
+
+// ExpiresAt returns the expiry of the current access token, or a zero time if there is none or if its expiry is unknown.
+func (s *TokenSession) ExpiresAt() time.Time {
+	expiresAt := s.expiresAt.Load()
+	if expiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(expiresAt, 0)
+}
+
+// RefreshFailures returns the number of renewals of an access token that failed, either with its refresh token or by
+// granting a new one with the credentials of the session. A renewal counts once, even when both failed.
+func (s *TokenSession) RefreshFailures() uint64 {
+	return s.refreshFailures.Load()
+}
+
+func (s *TokenSession) renew() error {
+	refreshFailed := false
+	if refreshableToken, ok := s.token.(uaa.RefreshableAccessToken); ok && refreshableToken.RefreshValue() != "" {
+		token, err := s.uaa.RefreshTokenGrant(refreshableToken.RefreshValue())
+		if err == nil {
+			s.setToken(token)
+			return nil
+		}
+
+		refreshFailed = true
+		log.Warnf("Error refreshing UAA access token, requesting a new one: %v", err)
+	}
+
+	token, err := s.grant()
+	// a renewal counts as a single failure, even when a new token cannot be granted after the refresh token failed
+	if refreshFailed || err != nil {
+		s.refreshFailures.Add(1)
+	}
+	if err != nil {
+		return fmt.Errorf("error renewing UAA access token: %v", err)
+	}
+	s.setToken(token)
+
+	return nil
+}
+
+func (s *TokenSession) setToken(token uaa.AccessToken) {
+	s.token = token
+
+	var expiresAt int64
+	if tokenInfo, err := uaa.NewTokenInfoFromValue(token.Value()); err == nil {
+		expiresAt = int64(tokenInfo.ExpiredAt)
+	}
+	s.expiresAt.Store(expiresAt)
+}
+
+func (s *TokenSession) expiring() bool {
+	expiresAt := s.ExpiresAt()
+	return !expiresAt.IsZero() && time.Until(expiresAt) < ExpiryMargin
+}
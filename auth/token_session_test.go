@@ -0,0 +1,205 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/uaa"
+	"github.com/cloudfoundry/bosh-cli/uaa/uaafakes"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/auth"
+)
+
+func tokenValue(name string, expiresAt time.Time) string {
+	encoding := base64.RawURLEncoding
+	return fmt.Sprintf(
+		"%s.%s.%s",
+		encoding.EncodeToString([]byte(`{"alg":"none"}`)),
+		encoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix()))),
+		name,
+	)
+}
+
+var _ = ginkgo.Describe("TokenSession", func() {
+	var (
+		err            error
+		authHeader     string
+		retried        bool
+		fakeUAA        *uaafakes.FakeUAA
+		tokenSession   *auth.TokenSession
+		validUntil     time.Time
+		expiringAt     time.Time
+		firstToken     uaa.AccessToken
+		refreshedToken uaa.AccessToken
+		grantedToken   uaa.AccessToken
+	)
+
+	ginkgo.BeforeEach(func() {
+		retried = false
+		fakeUAA = &uaafakes.FakeUAA{}
+		validUntil = time.Now().Add(time.Hour).Truncate(time.Second)
+		expiringAt = time.Now().Add(auth.ExpiryMargin / 2)
+	})
+
+	ginkgo.Context("with the password grant", func() {
+		ginkgo.BeforeEach(func() {
+			firstToken = uaa.NewRefreshableAccessToken("bearer", tokenValue("first", validUntil), "first-refresh")
+			refreshedToken = uaa.NewRefreshableAccessToken("bearer", tokenValue("refreshed", validUntil), "refreshed-refresh")
+			grantedToken = uaa.NewRefreshableAccessToken("bearer", tokenValue("granted", validUntil), "granted-refresh")
+
+			fakeUAA.OwnerPasswordCredentialsGrantReturnsOnCall(0, firstToken, nil)
+			fakeUAA.OwnerPasswordCredentialsGrantReturnsOnCall(1, grantedToken, nil)
+			fakeUAA.RefreshTokenGrantReturns(refreshedToken, nil)
+
+			tokenSession = auth.NewPasswordSession(fakeUAA, "fake-username", "fake-password")
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			authHeader, err = tokenSession.TokenFunc(retried)
+		})
+
+		ginkgo.It("grants a token with the user credentials", func() {
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(authHeader).To(gomega.Equal("bearer " + firstToken.Value()))
+			gomega.Expect(fakeUAA.OwnerPasswordCredentialsGrantArgsForCall(0)).To(gomega.Equal([]uaa.PromptAnswer{
+				{Key: "username", Value: "fake-username"},
+				{Key: "password", Value: "fake-password"},
+			}))
+		})
+
+		ginkgo.It("reports the token expiry", func() {
+			gomega.Expect(tokenSession.ExpiresAt()).To(gomega.BeTemporally("==", validUntil))
+		})
+
+		ginkgo.It("reuses the token while it is valid", func() {
+			gomega.Expect(tokenSession.TokenFunc(false)).To(gomega.Equal("bearer " + firstToken.Value()))
+			gomega.Expect(fakeUAA.OwnerPasswordCredentialsGrantCallCount()).To(gomega.Equal(1))
+			gomega.Expect(fakeUAA.RefreshTokenGrantCallCount()).To(gomega.Equal(0))
+		})
+
+		ginkgo.Context("when the BOSH Director rejects the token", func() {
+			ginkgo.It("refreshes it with its refresh token", func() {
+				gomega.Expect(tokenSession.TokenFunc(true)).To(gomega.Equal("bearer " + refreshedToken.Value()))
+				gomega.Expect(fakeUAA.RefreshTokenGrantArgsForCall(0)).To(gomega.Equal("first-refresh"))
+			})
+
+			ginkgo.It("rotates the refresh token", func() {
+				_, _ = tokenSession.TokenFunc(true)
+				_, _ = tokenSession.TokenFunc(true)
+				gomega.Expect(fakeUAA.RefreshTokenGrantArgsForCall(1)).To(gomega.Equal("refreshed-refresh"))
+			})
+		})
+
+		ginkgo.Context("when the token is about to expire", func() {
+			ginkgo.BeforeEach(func() {
+				firstToken = uaa.NewRefreshableAccessToken("bearer", tokenValue("first", expiringAt), "first-refresh")
+				fakeUAA.OwnerPasswordCredentialsGrantReturnsOnCall(0, firstToken, nil)
+			})
+
+			ginkgo.It("refreshes it before it expires", func() {
+				gomega.Expect(tokenSession.TokenFunc(false)).To(gomega.Equal("bearer " + refreshedToken.Value()))
+				gomega.Expect(tokenSession.ExpiresAt()).To(gomega.BeTemporally("==", validUntil))
+			})
+		})
+
+		ginkgo.Context("when the refresh token has expired", func() {
+			ginkgo.BeforeEach(func() {
+				fakeUAA.RefreshTokenGrantReturns(nil, errors.New("invalid_token"))
+			})
+
+			ginkgo.It("grants a new token with the user credentials", func() {
+				gomega.Expect(tokenSession.TokenFunc(true)).To(gomega.Equal("bearer " + grantedToken.Value()))
+				gomega.Expect(fakeUAA.OwnerPasswordCredentialsGrantCallCount()).To(gomega.Equal(2))
+			})
+
+			ginkgo.It("reports the refresh failure", func() {
+				_, _ = tokenSession.TokenFunc(true)
+				gomega.Expect(tokenSession.RefreshFailures()).To(gomega.Equal(uint64(1)))
+			})
+
+			ginkgo.Context("and a new token cannot be granted", func() {
+				ginkgo.BeforeEach(func() {
+					fakeUAA.OwnerPasswordCredentialsGrantReturnsOnCall(1, nil, errors.New("unauthorized"))
+				})
+
+				ginkgo.It("returns an error", func() {
+					_, err = tokenSession.TokenFunc(true)
+					gomega.Expect(err).To(gomega.HaveOccurred())
+					gomega.Expect(err.Error()).To(gomega.ContainSubstring("error renewing UAA access token: unauthorized"))
+				})
+
+				ginkgo.It("reports a single failure for the renewal", func() {
+					_, _ = tokenSession.TokenFunc(true)
+					gomega.Expect(tokenSession.RefreshFailures()).To(gomega.Equal(uint64(1)))
+				})
+			})
+		})
+
+		ginkgo.Context("when a token cannot be granted", func() {
+			ginkgo.BeforeEach(func() {
+				fakeUAA.OwnerPasswordCredentialsGrantReturnsOnCall(0, nil, errors.New("unauthorized"))
+			})
+
+			ginkgo.It("returns an error", func() {
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				gomega.Expect(err.Error()).To(gomega.ContainSubstring("error requesting UAA access token: unauthorized"))
+			})
+		})
+	})
+
+	ginkgo.Context("with the client credentials grant", func() {
+		ginkgo.BeforeEach(func() {
+			firstToken = uaa.NewAccessToken("bearer", tokenValue("first", validUntil))
+			grantedToken = uaa.NewAccessToken("bearer", tokenValue("granted", validUntil))
+
+			fakeUAA.ClientCredentialsGrantReturnsOnCall(0, firstToken, nil)
+			fakeUAA.ClientCredentialsGrantReturnsOnCall(1, grantedToken, nil)
+
+			tokenSession = auth.NewClientCredentialsSession(fakeUAA)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			authHeader, err = tokenSession.TokenFunc(retried)
+		})
+
+		ginkgo.It("grants a token with the client credentials", func() {
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(authHeader).To(gomega.Equal("bearer " + firstToken.Value()))
+		})
+
+		ginkgo.Context("when the BOSH Director rejects the token", func() {
+			ginkgo.It("grants a new token without reporting a refresh failure", func() {
+				gomega.Expect(tokenSession.TokenFunc(true)).To(gomega.Equal("bearer " + grantedToken.Value()))
+				gomega.Expect(fakeUAA.RefreshTokenGrantCallCount()).To(gomega.Equal(0))
+				gomega.Expect(tokenSession.RefreshFailures()).To(gomega.BeZero())
+			})
+
+			ginkgo.Context("and a new token cannot be granted", func() {
+				ginkgo.BeforeEach(func() {
+					fakeUAA.ClientCredentialsGrantReturnsOnCall(1, nil, errors.New("unauthorized"))
+				})
+
+				ginkgo.It("reports the renewal failure", func() {
+					_, err = tokenSession.TokenFunc(true)
+					gomega.Expect(err).To(gomega.HaveOccurred())
+					gomega.Expect(tokenSession.RefreshFailures()).To(gomega.Equal(uint64(1)))
+				})
+			})
+		})
+
+		ginkgo.Context("when the token expiry cannot be read", func() {
+			ginkgo.BeforeEach(func() {
+				fakeUAA.ClientCredentialsGrantReturnsOnCall(0, uaa.NewAccessToken("bearer", "opaque-token"), nil)
+			})
+
+			ginkgo.It("reports no expiry and reuses the token", func() {
+				gomega.Expect(tokenSession.ExpiresAt().IsZero()).To(gomega.BeTrue())
+				gomega.Expect(tokenSession.TokenFunc(false)).To(gomega.Equal("bearer opaque-token"))
+			})
+		})
+	})
+})
@@ -1,70 +1,117 @@
 package main
 
 import (
+	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	kingpin "github.com/alecthomas/kingpin/v2"
 	"github.com/cloudfoundry/bosh-cli/director"
 	"github.com/cloudfoundry/bosh-cli/uaa"
+	"github.com/cloudfoundry/bosh-utils/httpclient"
 	"github.com/cloudfoundry/bosh-utils/logger"
 	"github.com/cloudfoundry/bosh-utils/system"
 	"github.com/prometheus/client_golang/prometheus"
 	client_version "github.com/prometheus/client_golang/prometheus/collectors/version"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/common/version"
+	"github.com/prometheus/exporter-toolkit/web"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/cloudfoundry/bosh_exporter/auth"
 	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/config"
 	"github.com/cloudfoundry/bosh_exporter/deployments"
 	"github.com/cloudfoundry/bosh_exporter/filters"
+	"github.com/cloudfoundry/bosh_exporter/tunnel"
 )
 
 var (
-	boshURL = kingpin.Flag(
+	configFile = kingpin.Flag(
+		"config.file", "YAML configuration file of the BOSH Directors to monitor and probe, reloaded on SIGHUP or on a POST to /-/reload. The bosh.*, filter.*, sd.* and metrics.environment flags take precedence over it ($BOSH_EXPORTER_CONFIG_FILE)",
+	).Envar("BOSH_EXPORTER_CONFIG_FILE").ExistingFile()
+
+	boshURL = directorFlag(
 		"bosh.url", "BOSH URL ($BOSH_EXPORTER_BOSH_URL)",
-	).Envar("BOSH_EXPORTER_BOSH_URL").Required().String()
+	).Envar("BOSH_EXPORTER_BOSH_URL").String()
 
-	boshUsername = kingpin.Flag(
+	boshUsername = directorFlag(
 		"bosh.username", "BOSH Username ($BOSH_EXPORTER_BOSH_USERNAME)",
 	).Envar("BOSH_EXPORTER_BOSH_USERNAME").String()
 
-	boshPassword = kingpin.Flag(
+	boshPassword = directorFlag(
 		"bosh.password", "BOSH Password ($BOSH_EXPORTER_BOSH_PASSWORD)",
 	).Envar("BOSH_EXPORTER_BOSH_PASSWORD").String()
 
-	boshUAAClientID = kingpin.Flag(
+	boshPasswordFile = directorFlag(
+		"bosh.password-file", "File containing the BOSH Password, read again when it changes ($BOSH_EXPORTER_BOSH_PASSWORD_FILE)",
+	).Envar("BOSH_EXPORTER_BOSH_PASSWORD_FILE").ExistingFile()
+
+	boshUAAClientID = directorFlag(
 		"bosh.uaa.client-id", "BOSH UAA Client ID ($BOSH_EXPORTER_BOSH_UAA_CLIENT_ID)",
 	).Envar("BOSH_EXPORTER_BOSH_UAA_CLIENT_ID").String()
 
-	boshUAAClientSecret = kingpin.Flag(
+	boshUAAClientSecret = directorFlag(
 		"bosh.uaa.client-secret", "BOSH UAA Client Secret ($BOSH_EXPORTER_BOSH_UAA_CLIENT_SECRET)",
 	).Envar("BOSH_EXPORTER_BOSH_UAA_CLIENT_SECRET").String()
 
+	boshUAAClientSecretFile = directorFlag(
+		"bosh.uaa.client-secret-file", "File containing the BOSH UAA Client Secret, read again when it changes ($BOSH_EXPORTER_BOSH_UAA_CLIENT_SECRET_FILE)",
+	).Envar("BOSH_EXPORTER_BOSH_UAA_CLIENT_SECRET_FILE").ExistingFile()
+
 	boshLogLevel = kingpin.Flag(
 		"bosh.log-level", "BOSH Log Level ($BOSH_EXPORTER_BOSH_LOG_LEVEL)",
 	).Envar("BOSH_EXPORTER_BOSH_LOG_LEVEL").Default("ERROR").String()
 
-	boshCACertFile = kingpin.Flag(
+	boshCACertFile = directorFlag(
 		"bosh.ca-cert-file", "BOSH CA Certificate file ($BOSH_EXPORTER_BOSH_CA_CERT_FILE)",
-	).Envar("BOSH_EXPORTER_BOSH_CA_CERT_FILE").Required().ExistingFile()
+	).Envar("BOSH_EXPORTER_BOSH_CA_CERT_FILE").ExistingFile()
+
+	boshAllProxy = directorFlag(
+		"bosh.all-proxy", "Proxy to connect to the BOSH Director and its UAA through, as the bosh CLI BOSH_ALL_PROXY: ssh+socks5://user@host:port?private-key=file tunnels through a jumpbox ($BOSH_EXPORTER_BOSH_ALL_PROXY)",
+	).Envar("BOSH_EXPORTER_BOSH_ALL_PROXY").String()
+
+	boshConnectMinBackoff = kingpin.Flag(
+		"bosh.connect-min-backoff", "Time to wait before retrying to connect to a BOSH Director that cannot be reached, doubled after every failed attempt ($BOSH_EXPORTER_BOSH_CONNECT_MIN_BACKOFF)",
+	).Envar("BOSH_EXPORTER_BOSH_CONNECT_MIN_BACKOFF").Default("1s").Duration()
+
+	boshConnectMaxBackoff = kingpin.Flag(
+		"bosh.connect-max-backoff", "Maximum time to wait before retrying to connect to a BOSH Director that cannot be reached ($BOSH_EXPORTER_BOSH_CONNECT_MAX_BACKOFF)",
+	).Envar("BOSH_EXPORTER_BOSH_CONNECT_MAX_BACKOFF").Default("5m").Duration()
 
-	filterDeployments = kingpin.Flag(
+	boshDirectorsFile = kingpin.Flag(
+		"bosh.directors-file", "YAML file listing the BOSH Directors to monitor, instead of the single one set with the bosh.url flag ($BOSH_EXPORTER_BOSH_DIRECTORS_FILE)",
+	).Envar("BOSH_EXPORTER_BOSH_DIRECTORS_FILE").ExistingFile()
+
+	probeModulesFile = kingpin.Flag(
+		"probe.modules-file", "YAML file listing the credentials and filters used to probe BOSH Directors ($BOSH_EXPORTER_PROBE_MODULES_FILE)",
+	).Envar("BOSH_EXPORTER_PROBE_MODULES_FILE").ExistingFile()
+
+	probeClientTTL = kingpin.Flag(
+		"probe.client-ttl", "Time after which the BOSH client of a probe target that was not probed since is dropped ($BOSH_EXPORTER_PROBE_CLIENT_TTL)",
+	).Envar("BOSH_EXPORTER_PROBE_CLIENT_TTL").Default("1h").Duration()
+
+	filterDeployments = directorFlag(
 		"filter.deployments", "Comma separated deployments to filter ($BOSH_EXPORTER_FILTER_DEPLOYMENTS)",
 	).Envar("BOSH_EXPORTER_FILTER_DEPLOYMENTS").Default("").String()
 
-	filterAZs = kingpin.Flag(
+	filterAZs = directorFlag(
 		"filter.azs", "Comma separated AZs to filter ($BOSH_EXPORTER_FILTER_AZS)",
 	).Envar("BOSH_EXPORTER_FILTER_AZS").Default("").String()
 
-	filterCollectors = kingpin.Flag(
-		"filter.collectors", "Comma separated collectors to filter (Deployments,Jobs,ServiceDiscovery) ($BOSH_EXPORTER_FILTER_COLLECTORS)",
+	filterCollectors = directorFlag(
+		"filter.collectors", "Comma separated collectors to filter (Certificates,Deployments,Director,Events,Jobs,Orphans,ServiceDiscovery,Tasks) ($BOSH_EXPORTER_FILTER_COLLECTORS)",
 	).Envar("BOSH_EXPORTER_FILTER_COLLECTORS").Default("").String()
 
-	filterCIDRs = kingpin.Flag(
+	filterCIDRs = directorFlag(
 		"filter.cidrs", "Comma separated CIDR to filter available instance IPs ($BOSH_EXPORTER_FILTER_CIDRS)",
 	).Envar("BOSH_EXPORTER_FILTER_CIDRS").Default("0.0.0.0/0").String()
 
@@ -72,85 +119,137 @@ var (
 		"metrics.namespace", "Metrics Namespace ($BOSH_EXPORTER_METRICS_NAMESPACE)",
 	).Envar("BOSH_EXPORTER_METRICS_NAMESPACE").Default("bosh").String()
 
-	metricsEnvironment = kingpin.Flag(
+	metricsEnvironment = directorFlag(
 		"metrics.environment", "Environment label to be attached to metrics ($BOSH_EXPORTER_METRICS_ENVIRONMENT)",
-	).Envar("BOSH_EXPORTER_METRICS_ENVIRONMENT").Required().String()
+	).Envar("BOSH_EXPORTER_METRICS_ENVIRONMENT").String()
 
-	sdFilename = kingpin.Flag(
+	sdFilename = directorFlag(
 		"sd.filename", "Full path to the Service Discovery output file ($BOSH_EXPORTER_SD_FILENAME)",
 	).Envar("BOSH_EXPORTER_SD_FILENAME").Default("bosh_target_groups.json").String()
 
-	sdProcessesRegexp = kingpin.Flag(
+	sdProcessesRegexp = directorFlag(
 		"sd.processes_regexp", "Regexp to filter Service Discovery processes names ($BOSH_EXPORTER_SD_PROCESSES_REGEXP)",
 	).Envar("BOSH_EXPORTER_SD_PROCESSES_REGEXP").Default("").String()
 
-	listenAddress = kingpin.Flag(
-		"web.listen-address", "Address to listen on for web interface and telemetry ($BOSH_EXPORTER_WEB_LISTEN_ADDRESS)",
-	).Envar("BOSH_EXPORTER_WEB_LISTEN_ADDRESS").Default(":9190").String()
+	deploymentsRefreshInterval = kingpin.Flag(
+		"deployments.refresh-interval", "Interval at which the BOSH deployments are fetched in the background. If not set, they are fetched on every scrape. The other BOSH Director calls are still made on every scrape ($BOSH_EXPORTER_DEPLOYMENTS_REFRESH_INTERVAL)",
+	).Envar("BOSH_EXPORTER_DEPLOYMENTS_REFRESH_INTERVAL").Default("0s").Duration()
 
-	metricsPath = kingpin.Flag(
-		"web.telemetry-path", "Path under which to expose Prometheus metrics ($BOSH_EXPORTER_WEB_TELEMETRY_PATH)",
-	).Envar("BOSH_EXPORTER_WEB_TELEMETRY_PATH").Default("/metrics").String()
+	deploymentsFetchWorkers = kingpin.Flag(
+		"deployments.fetch-workers", "Maximum number of BOSH deployments fetched at the same time. If set to 0, all deployments are fetched at the same time ($BOSH_EXPORTER_DEPLOYMENTS_FETCH_WORKERS)",
+	).Envar("BOSH_EXPORTER_DEPLOYMENTS_FETCH_WORKERS").Default("10").Int()
+
+	deploymentsDumpFile = kingpin.Flag(
+		"deployments.dump-file", "Write the deployments of the BOSH Director to this JSON file and exit, so they can be replayed with the deployments.source-file flag ($BOSH_EXPORTER_DEPLOYMENTS_DUMP_FILE)",
+	).Envar("BOSH_EXPORTER_DEPLOYMENTS_DUMP_FILE").String()
+
+	deploymentsSourceFile = kingpin.Flag(
+		"deployments.source-file", "JSON file written with the deployments.dump-file flag to serve the deployments from, instead of a live BOSH Director ($BOSH_EXPORTER_DEPLOYMENTS_SOURCE_FILE)",
+	).Envar("BOSH_EXPORTER_DEPLOYMENTS_SOURCE_FILE").ExistingFile()
+
+	directorInfoRefreshInterval = kingpin.Flag(
+		"director.info-refresh-interval", "Interval at which the BOSH Director info is re-read ($BOSH_EXPORTER_DIRECTOR_INFO_REFRESH_INTERVAL)",
+	).Envar("BOSH_EXPORTER_DIRECTOR_INFO_REFRESH_INTERVAL").Default("5m").Duration()
+
+	tasksRecentLimit = kingpin.Flag(
+		"tasks.recent-limit", "Number of recent BOSH Director tasks to report on, in addition to the current ones ($BOSH_EXPORTER_TASKS_RECENT_LIMIT)",
+	).Envar("BOSH_EXPORTER_TASKS_RECENT_LIMIT").Default("100").Int()
+
+	listenAddresses = kingpin.Flag(
+		"web.listen-address", "Addresses to listen on for web interface and telemetry, repeatable for multiple addresses ($BOSH_EXPORTER_WEB_LISTEN_ADDRESS)",
+	).Envar("BOSH_EXPORTER_WEB_LISTEN_ADDRESS").Default(":9190").Strings()
+
+	webConfigFile = kingpin.Flag(
+		"web.config.file", "Web configuration file enabling TLS and basic auth on every endpoint, in the Prometheus exporter-toolkit format ($BOSH_EXPORTER_WEB_CONFIG_FILE)",
+	).Envar("BOSH_EXPORTER_WEB_CONFIG_FILE").ExistingFile()
 
 	authUsername = kingpin.Flag(
-		"web.auth.username", "Username for web interface basic auth ($BOSH_EXPORTER_WEB_AUTH_USERNAME)",
+		"web.auth.username", "Deprecated, use web.config.file instead. Username for web interface basic auth ($BOSH_EXPORTER_WEB_AUTH_USERNAME)",
 	).Envar("BOSH_EXPORTER_WEB_AUTH_USERNAME").String()
 
 	authPassword = kingpin.Flag(
-		"web.auth.password", "Password for web interface basic auth ($BOSH_EXPORTER_WEB_AUTH_PASSWORD)",
+		"web.auth.password", "Deprecated, use web.config.file instead. Password for web interface basic auth ($BOSH_EXPORTER_WEB_AUTH_PASSWORD)",
 	).Envar("BOSH_EXPORTER_WEB_AUTH_PASSWORD").String()
 
 	tlsCertFile = kingpin.Flag(
-		"web.tls.cert_file", "Path to a file that contains the TLS certificate (PEM format). If the certificate is signed by a certificate authority, the file should be the concatenation of the server's certificate, any intermediates, and the CA's certificate ($BOSH_EXPORTER_WEB_TLS_CERTFILE)",
+		"web.tls.cert_file", "Deprecated, use web.config.file instead. Path to a file that contains the TLS certificate (PEM format) ($BOSH_EXPORTER_WEB_TLS_CERTFILE)",
 	).Envar("BOSH_EXPORTER_WEB_TLS_CERTFILE").ExistingFile()
 
 	tlsKeyFile = kingpin.Flag(
-		"web.tls.key_file", "Path to a file that contains the TLS private key (PEM format) ($BOSH_EXPORTER_WEB_TLS_KEYFILE)",
+		"web.tls.key_file", "Deprecated, use web.config.file instead. Path to a file that contains the TLS private key (PEM format) ($BOSH_EXPORTER_WEB_TLS_KEYFILE)",
 	).Envar("BOSH_EXPORTER_WEB_TLS_KEYFILE").ExistingFile()
+
+	metricsPath = kingpin.Flag(
+		"web.telemetry-path", "Path under which to expose Prometheus metrics ($BOSH_EXPORTER_WEB_TELEMETRY_PATH)",
+	).Envar("BOSH_EXPORTER_WEB_TELEMETRY_PATH").Default("/metrics").String()
+
+	probePath = kingpin.Flag(
+		"web.probe-path", "Path under which to expose the metrics of the probed BOSH Directors ($BOSH_EXPORTER_WEB_PROBE_PATH)",
+	).Envar("BOSH_EXPORTER_WEB_PROBE_PATH").Default("/probe").String()
+
+	scrapeTimeoutOffset = kingpin.Flag(
+		"web.scrape-timeout-offset", "Offset to subtract from the Prometheus scrape timeout, so the metrics collected so far are returned before Prometheus gives up ($BOSH_EXPORTER_WEB_SCRAPE_TIMEOUT_OFFSET)",
+	).Envar("BOSH_EXPORTER_WEB_SCRAPE_TIMEOUT_OFFSET").Default("500ms").Duration()
+
+	secretsCheckInterval = kingpin.Flag(
+		"secrets.check-interval", "Interval at which the secret files are checked for changes, to reload the configuration when one of them changed. If set to 0, they are only read again on reload ($BOSH_EXPORTER_SECRETS_CHECK_INTERVAL)",
+	).Envar("BOSH_EXPORTER_SECRETS_CHECK_INTERVAL").Default("30s").Duration()
 )
 
-func init() {
-	prometheus.MustRegister(client_version.NewCollector(*metricsNamespace))
-}
+// directorFlagsSetByUser records which of the flags declared with directorFlag were set on the command line.
+var directorFlagsSetByUser = map[string]*bool{}
 
-type basicAuthHandler struct {
-	handler  http.HandlerFunc
-	username string
-	password string
+// directorFlag declares a flag setting a BOSH Director, which takes precedence over the configuration file when it
+// is set on the command line or with its environment variable.
+func directorFlag(name, help string) *kingpin.FlagClause {
+	setByUser := new(bool)
+	directorFlagsSetByUser[name] = setByUser
+	return kingpin.Flag(name, help).IsSetByUser(setByUser)
 }
 
-func (h *basicAuthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	username, password, ok := r.BasicAuth()
-	if !ok || username != h.username || password != h.password {
-		log.Errorf("Invalid HTTP auth from `%s`", r.RemoteAddr)
-		w.Header().Set("WWW-Authenticate", "Basic realm=\"metrics\"")
-		http.Error(w, "Invalid username or password", http.StatusUnauthorized)
-		return
+func directorFlagSetByUser(name string) bool {
+	if *directorFlagsSetByUser[name] {
+		return true
 	}
-	h.handler(w, r)
+	return kingpin.CommandLine.GetFlag(name).HasEnvarValue()
 }
 
-type boshConfigUpdater struct{}
-
-func (cu boshConfigUpdater) UpdateConfigWithToken(_ string, _ uaa.AccessToken) error {
-	return nil
-}
-func (cu boshConfigUpdater) Save() error {
-	return nil
+func init() {
+	prometheus.MustRegister(client_version.NewCollector(*metricsNamespace))
 }
 
-func prometheusHandler() http.Handler {
-	handler := promhttp.Handler()
+func prometheusHandler(boshCollectors func() []*collectors.BoshCollector) http.Handler {
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := scrapeContext(r)
+		defer cancel()
 
-	if *authUsername != "" && *authPassword != "" {
-		handler = &basicAuthHandler{
-			handler:  promhttp.Handler().ServeHTTP,
-			username: *authUsername,
-			password: *authPassword,
+		// every BOSH Director is collected concurrently by the registry, so a slow or unreachable one only
+		// affects its own metrics
+		registry := prometheus.NewRegistry()
+		for _, boshCollector := range boshCollectors() {
+			registry.MustRegister(boshCollector.WithContext(ctx))
 		}
+		gatherers := prometheus.Gatherers{prometheus.DefaultGatherer, registry}
+		promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	})
+
+	return promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer, handler)
+}
+
+// scrapeContext bounds a scrape by the timeout Prometheus sends in the X-Prometheus-Scrape-Timeout-Seconds header,
+// minus the configured offset, so that a partial response still reaches Prometheus in time.
+func scrapeContext(r *http.Request) (context.Context, context.CancelFunc) {
+	timeoutSeconds, err := strconv.ParseFloat(r.Header.Get("X-Prometheus-Scrape-Timeout-Seconds"), 64)
+	if err != nil || timeoutSeconds <= 0 {
+		return context.WithCancel(r.Context())
+	}
+
+	timeout := time.Duration(timeoutSeconds * float64(time.Second))
+	if timeout > *scrapeTimeoutOffset {
+		timeout -= *scrapeTimeoutOffset
 	}
 
-	return handler
+	return context.WithTimeout(r.Context(), timeout)
 }
 
 func readCaCert(caCertFile string, logger logger.Logger) (string, error) {
@@ -173,181 +272,380 @@ func readCaCert(caCertFile string, logger logger.Logger) (string, error) {
 	return "", nil
 }
 
-func buildBOSHClient() (director.Director, error) {
+// loadConfigFile reads the configuration file set with the config.file flag, if any.
+func loadConfigFile() (config.File, error) {
+	if *configFile == "" {
+		return config.File{}, nil
+	}
+	return config.LoadFile(*configFile)
+}
+
+// defaultDirectorConfig returns the settings of the bosh.*, filter.*, sd.* and metrics.environment flags merged with
+// the top-level settings of the configuration file: a flag set by the user takes precedence over the file, which
+// takes precedence over the flag default. These are also the defaults of the BOSH Directors and probe modules read
+// from files.
+func defaultDirectorConfig(file config.Director) config.Director {
+	directorConfig := config.Director{
+		Environment: stringSetting("metrics.environment", *metricsEnvironment, file.Environment),
+		URL:         stringSetting("bosh.url", *boshURL, file.URL),
+		Username:    stringSetting("bosh.username", *boshUsername, file.Username),
+		UAAClientID: stringSetting("bosh.uaa.client-id", *boshUAAClientID, file.UAAClientID),
+		CACertFile:  stringSetting("bosh.ca-cert-file", *boshCACertFile, file.CACertFile),
+		AllProxy:    stringSetting("bosh.all-proxy", *boshAllProxy, file.AllProxy),
+		SDFilename:  stringSetting("sd.filename", *sdFilename, file.SDFilename),
+		Filters: config.Filters{
+			Deployments:     listSetting("filter.deployments", *filterDeployments, file.Filters.Deployments),
+			AZs:             listSetting("filter.azs", *filterAZs, file.Filters.AZs),
+			Collectors:      listSetting("filter.collectors", *filterCollectors, file.Filters.Collectors),
+			CIDRs:           listSetting("filter.cidrs", *filterCIDRs, file.Filters.CIDRs),
+			ProcessesRegexp: stringSetting("sd.processes_regexp", *sdProcessesRegexp, file.Filters.ProcessesRegexp),
+		},
+	}
+
+	directorConfig.Password, directorConfig.PasswordFile = secretSetting(
+		"bosh.password", *boshPassword, "bosh.password-file", *boshPasswordFile, file.Password, file.PasswordFile,
+	)
+	directorConfig.UAAClientSecret, directorConfig.UAAClientSecretFile = secretSetting(
+		"bosh.uaa.client-secret", *boshUAAClientSecret, "bosh.uaa.client-secret-file", *boshUAAClientSecretFile,
+		file.UAAClientSecret, file.UAAClientSecretFile,
+	)
+
+	return directorConfig
+}
+
+func stringSetting(flagName string, flagValue string, fileValue string) string {
+	if fileValue == "" || directorFlagSetByUser(flagName) {
+		return flagValue
+	}
+	return fileValue
+}
+
+// secretSetting returns a secret and the file it is read from. They are set together, so that a secret file set on the
+// command line replaces a secret set in the configuration file, and the other way round.
+func secretSetting(flagName string, flagValue string, fileFlagName string, fileFlagValue string, fileValue string, fileFile string) (string, string) {
+	if (fileValue == "" && fileFile == "") || directorFlagSetByUser(flagName) || directorFlagSetByUser(fileFlagName) {
+		return flagValue, fileFlagValue
+	}
+	return fileValue, fileFile
+}
+
+func listSetting(flagName string, flagValue string, fileValue []string) []string {
+	if fileValue == nil || directorFlagSetByUser(flagName) {
+		return splitFlag(flagValue)
+	}
+	return fileValue
+}
+
+// directorsConfig returns the BOSH Directors to monitor, read from the bosh.directors-file flag or the directors listed
+// in the configuration file, or else the single one set with the bosh.* flags or the configuration file top-level
+// settings. When only probe modules are set, there is no BOSH Director to monitor. When the deployments are replayed
+// from the deployments.source-file file, there is no BOSH Director to connect to either, so only the environment is
+// required.
+func directorsConfig(file config.File) ([]config.Director, error) {
+	defaults := defaultDirectorConfig(file.Director)
+
+	if *deploymentsSourceFile != "" {
+		if defaults.Environment == "" {
+			return nil, errors.New("required flag --metrics.environment not provided")
+		}
+		return []config.Director{defaults}, nil
+	}
+
+	if *boshDirectorsFile != "" {
+		return config.LoadDirectors(*boshDirectorsFile, defaults)
+	}
+
+	if len(file.Directors) > 0 {
+		directors, err := config.ResolveDirectors(file.Directors, defaults)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration file `%s`: %v", *configFile, err)
+		}
+		return directors, nil
+	}
+
+	if defaults.URL == "" && (*probeModulesFile != "" || len(file.Modules) > 0) {
+		return nil, nil
+	}
+
+	switch {
+	case defaults.URL == "":
+		return nil, errors.New("required flag --bosh.url not provided")
+	case defaults.CACertFile == "":
+		return nil, errors.New("required flag --bosh.ca-cert-file not provided")
+	case defaults.Environment == "":
+		return nil, errors.New("required flag --metrics.environment not provided")
+	}
+
+	directors := []config.Director{defaults}
+
+	return directors, config.ValidateDirectors(directors)
+}
+
+// modulesConfig returns the probe modules, read from the probe.modules-file flag or the configuration file.
+func modulesConfig(file config.File) (map[string]config.Director, error) {
+	defaults := defaultDirectorConfig(file.Director)
+
+	if *probeModulesFile != "" {
+		return config.LoadModules(*probeModulesFile, defaults)
+	}
+
+	if len(file.Modules) > 0 {
+		modules, err := config.ResolveModules(file.Modules, defaults)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration file `%s`: %v", *configFile, err)
+		}
+		return modules, nil
+	}
+
+	return nil, nil
+}
+
+func splitFlag(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// buildBOSHClient builds the client of a BOSH Director. When the BOSH Director delegates user management to UAA, the
+// session providing its access tokens is returned too. The proxy of the BOSH Director, if any, is released once ctx
+// is done.
+func buildBOSHClient(ctx context.Context, directorConfig config.Director) (director.Director, collectors.TokenSession, error) {
+	dialContext, release, err := proxyDialContext(directorConfig.AllProxy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	boshClient, tokenSession, err := newBOSHClient(directorConfig, dialContext)
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	context.AfterFunc(ctx, release)
+
+	return boshClient, tokenSession, nil
+}
+
+// newBOSHClient builds the client of a BOSH Director, whose HTTP clients dial with dialContext when it is set.
+func newBOSHClient(
+	directorConfig config.Director,
+	dialContext httpclient.DialContextFunc,
+) (director.Director, collectors.TokenSession, error) {
 	logLevel, err := logger.Levelify(*boshLogLevel)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	logger := logger.NewLogger(logLevel)
 
-	directorConfig, err := director.NewConfigFromURL(*boshURL)
+	boshConfig, err := director.NewConfigFromURL(directorConfig.URL)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	boshCACert, err := readCaCert(*boshCACertFile, logger)
+	boshCACert, err := readCaCert(directorConfig.CACertFile, logger)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	directorConfig.CACert = boshCACert
+	boshConfig.CACert = boshCACert
 
-	anonymousDirector, err := director.NewFactory(logger).New(directorConfig, nil, nil)
+	newDirector := func(taskReporter director.TaskReporter, fileReporter director.FileReporter) (director.Director, error) {
+		if dialContext == nil {
+			return director.NewFactory(logger).New(boshConfig, taskReporter, fileReporter)
+		}
+		certPool, err := boshConfig.CACertPool()
+		if err != nil {
+			return nil, err
+		}
+		return director.NewFactory(logger).NewWithHTTPClient(
+			boshConfig, newHTTPClient(certPool, dialContext), taskReporter, fileReporter,
+		)
+	}
+
+	anonymousDirector, err := newDirector(nil, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	boshInfo, err := anonymousDirector.Info()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	var tokenSession collectors.TokenSession
 	if boshInfo.Auth.Type != "uaa" {
-		directorConfig.Client = *boshUsername
-		directorConfig.ClientSecret = *boshPassword
+		boshConfig.Client = directorConfig.Username
+		boshConfig.ClientSecret = directorConfig.Password
 	} else {
 		uaaURL := boshInfo.Auth.Options["url"]
 		uaaURLStr, ok := uaaURL.(string)
 		if !ok {
-			return nil, fmt.Errorf("expected UAA URL '%s' to be a string", uaaURL)
+			return nil, nil, fmt.Errorf("expected UAA URL '%s' to be a string", uaaURL)
 		}
 
 		uaaConfig, err := uaa.NewConfigFromURL(uaaURLStr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		uaaConfig.CACert = boshCACert
 
-		if *boshUAAClientID != "" && *boshUAAClientSecret != "" {
-			uaaConfig.Client = *boshUAAClientID
-			uaaConfig.ClientSecret = *boshUAAClientSecret
+		if directorConfig.UAAClientID != "" && directorConfig.UAAClientSecret != "" {
+			uaaConfig.Client = directorConfig.UAAClientID
+			uaaConfig.ClientSecret = directorConfig.UAAClientSecret
 		} else {
 			uaaConfig.Client = "bosh_cli"
 		}
 
-		uaaFactory := uaa.NewFactory(logger)
-		uaaClient, err := uaaFactory.New(uaaConfig)
+		var uaaClient uaa.UAA
+		if dialContext == nil {
+			uaaClient, err = uaa.NewFactory(logger).New(uaaConfig)
+		} else {
+			var certPool *x509.CertPool
+			certPool, err = uaaConfig.CACertPool()
+			if err == nil {
+				uaaClient, err = uaa.NewFactory(logger).NewWithHTTPClient(uaaConfig, newHTTPClient(certPool, dialContext))
+			}
+		}
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		if *boshUAAClientID != "" && *boshUAAClientSecret != "" {
-			directorConfig.TokenFunc = uaa.NewClientTokenSession(uaaClient).TokenFunc
+		var uaaSession *auth.TokenSession
+		if directorConfig.UAAClientID != "" && directorConfig.UAAClientSecret != "" {
+			uaaSession = auth.NewClientCredentialsSession(uaaClient)
 		} else {
-			answers := []uaa.PromptAnswer{
-				{
-					Key:   "username",
-					Value: *boshUsername,
-				},
-				{
-					Key:   "password",
-					Value: *boshPassword,
-				},
-			}
-			accessToken, err := uaaClient.OwnerPasswordCredentialsGrant(answers)
-			if err != nil {
-				return nil, err
-			}
-
-			refreshToken := ""
-			if refreshableToken, ok := accessToken.(uaa.RefreshableAccessToken); ok {
-				refreshToken = refreshableToken.RefreshValue()
-			}
+			uaaSession = auth.NewPasswordSession(uaaClient, directorConfig.Username, directorConfig.Password)
+		}
 
-			origToken := uaa.NewRefreshableAccessToken(accessToken.Type(), accessToken.Value(), refreshToken)
-			directorConfig.TokenFunc = uaa.NewAccessTokenSession(uaaClient, origToken, boshConfigUpdater{}, "").TokenFunc
+		// the first token is granted now, so that wrong credentials fail the client creation
+		if _, err := uaaSession.TokenFunc(false); err != nil {
+			return nil, nil, err
 		}
+		boshConfig.TokenFunc = uaaSession.TokenFunc
+		tokenSession = uaaSession
 	}
 
-	boshFactory := director.NewFactory(logger)
-	boshClient, err := boshFactory.New(directorConfig, director.NewNoopTaskReporter(), director.NewNoopFileReporter())
+	boshClient, err := newDirector(director.NewNoopTaskReporter(), director.NewNoopFileReporter())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return boshClient, nil
+	return boshClient, tokenSession, nil
 }
 
-func main() {
-	kingpin.Version(version.Print("bosh_exporter"))
-	kingpin.HelpFlag.Short('h')
-	kingpin.Parse()
-
-	log.Infoln("Starting bosh_exporter", version.Info())
-	log.Infoln("Build context", version.BuildContext())
+// newBoshCollector connects to a BOSH Director and builds the collector of its metrics. When refreshInterval is set,
+// the deployments are fetched in the background, until ctx is done, instead of on every scrape.
+func newBoshCollector(ctx context.Context, directorConfig config.Director, refreshInterval time.Duration) (*collectors.BoshCollector, error) {
+	if *deploymentsSourceFile != "" {
+		return newSnapshotBoshCollector(*deploymentsSourceFile, directorConfig)
+	}
 
-	boshClient, err := buildBOSHClient()
+	boshClient, tokenSession, err := buildBOSHClient(ctx, directorConfig)
 	if err != nil {
-		log.Errorf("Error creating BOSH Client: %s", err.Error())
-		os.Exit(1)
+		return nil, fmt.Errorf("error creating BOSH Client: %v", err)
 	}
 
 	boshInfo, err := boshClient.Info()
 	if err != nil {
-		log.Errorf("Error reading BOSH Info: %s", err.Error())
-		os.Exit(1)
+		return nil, fmt.Errorf("error reading BOSH Info: %v", err)
 	}
-	log.Infof("Using BOSH Director `%s` (%s)", boshInfo.Name, boshInfo.UUID)
+	log.Infof("Using BOSH Director `%s` (%s) for environment `%s`", boshInfo.Name, boshInfo.UUID, directorConfig.Environment)
 
-	var deploymentsFilters []string
-	if *filterDeployments != "" {
-		deploymentsFilters = strings.Split(*filterDeployments, ",")
+	deploymentsFilter := filters.NewDeploymentsFilter(directorConfig.Filters.Deployments, boshClient)
+	deploymentsFetcher := deployments.NewFetcher(*deploymentsFilter, *deploymentsFetchWorkers)
+	if refreshInterval > 0 {
+		deploymentsFetcher.StartPolling(ctx, refreshInterval)
 	}
-	deploymentsFilter := filters.NewDeploymentsFilter(deploymentsFilters, boshClient)
-	deploymentsFetcher := deployments.NewFetcher(*deploymentsFilter)
 
-	var azsFilters []string
-	if *filterAZs != "" {
-		azsFilters = strings.Split(*filterAZs, ",")
-	}
-	azsFilter := filters.NewAZsFilter(azsFilters)
+	return buildBoshCollector(directorConfig, boshInfo.Name, boshInfo.UUID, deploymentsFetcher, boshClient, tokenSession)
+}
 
-	var collectorsFilters []string
-	if *filterCollectors != "" {
-		collectorsFilters = strings.Split(*filterCollectors, ",")
-	}
-	collectorsFilter, err := filters.NewCollectorsFilter(collectorsFilters)
+// buildBoshCollector builds the filters of a BOSH Director and the collector of its metrics.
+func buildBoshCollector(
+	directorConfig config.Director,
+	boshName string,
+	boshUUID string,
+	deploymentsFetcher collectors.DeploymentsFetcher,
+	boshClient director.Director,
+	tokenSession collectors.TokenSession,
+) (*collectors.BoshCollector, error) {
+	azsFilter := filters.NewAZsFilter(directorConfig.Filters.AZs)
+
+	collectorsFilter, err := filters.NewCollectorsFilter(directorConfig.Filters.Collectors)
 	if err != nil {
-		log.Error(err)
-		os.Exit(1)
+		return nil, err
 	}
 
-	var cidrFilters []string
-	if *filterCIDRs != "" {
-		cidrFilters = strings.Split(*filterCIDRs, ",")
-	}
-	cidrsFilter, err := filters.NewCidrFilter(cidrFilters)
+	cidrsFilter, err := filters.NewCidrFilter(directorConfig.Filters.CIDRs)
 	if err != nil {
-		log.Error(err)
-		os.Exit(1)
+		return nil, err
 	}
 
-	var processesFilters []string
-	if *sdProcessesRegexp != "" {
-		processesFilters = []string{*sdProcessesRegexp}
-	}
-	processesFilter, err := filters.NewRegexpFilter(processesFilters)
+	processesFilter, err := filters.NewRegexpFilter(directorConfig.Filters.ProcessesRegexps())
 	if err != nil {
-		log.Errorf("Error processing Processes Regexp: %v", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("error processing Processes Regexp: %v", err)
 	}
 
-	boshCollector := collectors.NewBoshCollector(
+	return collectors.NewBoshCollector(
 		*metricsNamespace,
-		*metricsEnvironment,
-		boshInfo.Name,
-		boshInfo.UUID,
-		*sdFilename,
+		directorConfig.Environment,
+		boshName,
+		boshUUID,
+		directorConfig.SDFilename,
 		deploymentsFetcher,
+		boshClient,
+		tokenSession,
+		*tasksRecentLimit,
+		*directorInfoRefreshInterval,
 		collectorsFilter,
 		azsFilter,
 		processesFilter,
 		cidrsFilter,
-	)
-	prometheus.MustRegister(boshCollector)
+	), nil
+}
+
+func main() {
+	kingpin.Version(version.Print("bosh_exporter"))
+	kingpin.HelpFlag.Short('h')
+	kingpin.Parse()
+
+	log.Infoln("Starting bosh_exporter", version.Info())
+	log.Infoln("Build context", version.BuildContext())
+
+	sshTunnels = tunnel.NewPool(*metricsNamespace)
+	prometheus.MustRegister(sshTunnels)
+
+	if *deploymentsDumpFile != "" {
+		if err := dumpDeployments(*deploymentsDumpFile); err != nil {
+			log.Error(err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	http.Handle(*metricsPath, prometheusHandler())
+	reloader := newReloader(*metricsNamespace)
+	prometheus.MustRegister(reloader)
+	if err := reloader.reload(); err != nil {
+		log.Error(err)
+		os.Exit(1)
+	}
+
+	go reloader.reloadOnSignal(syscall.SIGHUP)
+	if *secretsCheckInterval > 0 {
+		go reloader.reloadOnSecretChange(*secretsCheckInterval)
+	}
+
+	http.Handle("/-/reload", reloader)
+	http.Handle(*probePath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probeHandler := reloader.probeHandler()
+		if probeHandler == nil {
+			http.NotFound(w, r)
+			return
+		}
+		probeHandler.ServeHTTP(w, r)
+	}))
+	http.Handle(*metricsPath, prometheusHandler(reloader.boshCollectors))
 	http.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
 		_, _ = w.Write([]byte(`<html>
              <head><title>BOSH Exporter</title></head>
@@ -358,19 +656,38 @@ func main() {
              </html>`))
 	})
 
+	if deprecatedWebFlagsSet() {
+		if *webConfigFile != "" {
+			log.Error("The web.auth.* and web.tls.* flags cannot be used with the web.config.file flag, which replaces them")
+			os.Exit(1)
+		}
+		log.Warn("The web.auth.* and web.tls.* flags are deprecated, use the web.config.file flag instead")
+
+		filename, err := writeDeprecatedWebConfig()
+		if err != nil {
+			log.Errorf("Error writing the web configuration of the web.auth.* and web.tls.* flags: %v", err)
+			os.Exit(1)
+		}
+		*webConfigFile = filename
+	}
+
+	// the web configuration file is read again on every request, so that its users and certificates can be rotated,
+	// but it is checked now to fail fast on a typo
+	if err := web.Validate(*webConfigFile); err != nil {
+		log.Errorf("Invalid web configuration file `%s`: %v", *webConfigFile, err)
+		os.Exit(1)
+	}
+
 	server := &http.Server{
-		Addr:              *listenAddress,
 		ReadTimeout:       time.Second * 5,
 		ReadHeaderTimeout: time.Second * 10,
 	}
-
-	if *tlsCertFile != "" && *tlsKeyFile != "" {
-		log.Infoln("Listening TLS on", *listenAddress)
-		err = server.ListenAndServeTLS(*tlsCertFile, *tlsKeyFile)
-	} else {
-		log.Infoln("Listening on", *listenAddress)
-		err = server.ListenAndServe()
+	systemdSocket := false
+	webFlags := &web.FlagConfig{
+		WebListenAddresses: listenAddresses,
+		WebSystemdSocket:   &systemdSocket,
+		WebConfigFile:      webConfigFile,
 	}
 
-	log.Fatal(err)
+	log.Fatal(web.ListenAndServe(server, webFlags, slog.New(slog.NewTextHandler(os.Stderr, nil))))
 }
@@ -1,9 +1,13 @@
 package collectors
 
 import (
+	"context"
+	"errors"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/cloudfoundry/bosh-cli/director"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
@@ -11,14 +15,48 @@ import (
 	"github.com/cloudfoundry/bosh_exporter/filters"
 )
 
+// DeploymentsFetcher provides the BOSH deployments the collectors report on, and the statistics of how they were
+// fetched. It is implemented by deployments.Fetcher, which reads them from a BOSH Director, and by
+// deployments.SnapshotSource, which replays them from a file.
+type DeploymentsFetcher interface {
+	Deployments(ctx context.Context) ([]deployments.DeploymentInfo, error)
+	LastRefresh() (time.Time, time.Duration)
+	Reachable() bool
+	ReleasesCacheStats() (hits uint64, misses uint64)
+	FetchStats() (inFlight int, total uint64, queueWait time.Duration)
+	DeploymentsFetchStatus() map[string]deployments.DeploymentFetchStatus
+}
+
+// TokenSession provides the statistics of the UAA access tokens used to authenticate to the BOSH Director. It is
+// implemented by auth.TokenSession.
+type TokenSession interface {
+	ExpiresAt() time.Time
+	RefreshFailures() uint64
+}
+
 type BoshCollector struct {
-	enabledCollectors                   []Collector
-	deploymentsFetcher                  *deployments.Fetcher
-	totalBoshScrapesMetric              prometheus.Counter
-	totalBoshScrapeErrorsMetric         prometheus.Counter
-	lastBoshScrapeErrorMetric           prometheus.Gauge
-	lastBoshScrapeTimestampMetric       prometheus.Gauge
-	lastBoshScrapeDurationSecondsMetric prometheus.Gauge
+	directorCollectors                          []Collector
+	deploymentsCollectors                       []Collector
+	deploymentsFetcher                          DeploymentsFetcher
+	tokenSession                                TokenSession
+	totalBoshScrapesMetric                      prometheus.Counter
+	totalBoshScrapeErrorsMetric                 prometheus.Counter
+	lastBoshScrapeErrorDesc                     *prometheus.Desc
+	lastBoshScrapeTimestampDesc                 *prometheus.Desc
+	lastBoshScrapeDurationSecondsDesc           *prometheus.Desc
+	deploymentsSnapshotAgeSecondsDesc           *prometheus.Desc
+	lastDeploymentsRefreshDurationSecondsDesc   *prometheus.Desc
+	releasesCacheHitsMetric                     prometheus.CounterFunc
+	releasesCacheMissesMetric                   prometheus.CounterFunc
+	deploymentsFetchesInFlightMetric            prometheus.GaugeFunc
+	totalDeploymentsFetchesMetric               prometheus.CounterFunc
+	totalDeploymentsFetchQueueWaitSecondsMetric prometheus.CounterFunc
+	lastDeploymentScrapeSuccessDesc             *prometheus.Desc
+	lastDeploymentScrapeDurationSecondsDesc     *prometheus.Desc
+	lastDeploymentScrapeTimedOutDesc            *prometheus.Desc
+	totalDeploymentScrapeErrorsDesc             *prometheus.Desc
+	uaaTokenExpiryTimestampDesc                 *prometheus.Desc
+	totalUAATokenRefreshFailuresMetric          prometheus.CounterFunc
 }
 
 func NewBoshCollector(
@@ -27,22 +65,47 @@ func NewBoshCollector(
 	boshName string,
 	boshUUID string,
 	serviceDiscoveryFilename string,
-	deploymentsFetcher *deployments.Fetcher,
+	deploymentsFetcher DeploymentsFetcher,
+	boshClient director.Director,
+	tokenSession TokenSession,
+	recentTasksLimit int,
+	directorInfoRefreshInterval time.Duration,
 	collectorsFilter *filters.CollectorsFilter,
 	azsFilter *filters.AZsFilter,
 	processesFilter *filters.RegexpFilter,
 	cidrsFilter *filters.CidrFilter,
 ) *BoshCollector {
-	var enabledCollectors []Collector
+	// the collectors of the BOSH Director itself are kept apart from the ones of its deployments
+	var directorCollectors, deploymentsCollectors []Collector
+
+	if collectorsFilter.Enabled(filters.CertificatesCollector) {
+		certificatesCollector := NewCertificatesCollector(namespace, environment, boshName, boshUUID, boshClient)
+		directorCollectors = append(directorCollectors, certificatesCollector)
+	}
 
 	if collectorsFilter.Enabled(filters.DeploymentsCollector) {
 		deploymentsCollector := NewDeploymentsCollector(namespace, environment, boshName, boshUUID)
-		enabledCollectors = append(enabledCollectors, deploymentsCollector)
+		deploymentsCollectors = append(deploymentsCollectors, deploymentsCollector)
+	}
+
+	if collectorsFilter.Enabled(filters.DirectorCollector) {
+		directorCollector := NewDirectorCollector(namespace, environment, boshName, boshUUID, boshClient, directorInfoRefreshInterval)
+		directorCollectors = append(directorCollectors, directorCollector)
+	}
+
+	if collectorsFilter.Enabled(filters.EventsCollector) {
+		eventsCollector := NewEventsCollector(namespace, environment, boshName, boshUUID, boshClient)
+		directorCollectors = append(directorCollectors, eventsCollector)
 	}
 
 	if collectorsFilter.Enabled(filters.JobsCollector) {
 		jobsCollector := NewJobsCollector(namespace, environment, boshName, boshUUID, azsFilter, cidrsFilter)
-		enabledCollectors = append(enabledCollectors, jobsCollector)
+		deploymentsCollectors = append(deploymentsCollectors, jobsCollector)
+	}
+
+	if collectorsFilter.Enabled(filters.OrphansCollector) {
+		orphansCollector := NewOrphansCollector(namespace, environment, boshName, boshUUID, boshClient)
+		directorCollectors = append(directorCollectors, orphansCollector)
 	}
 
 	if collectorsFilter.Enabled(filters.ServiceDiscoveryCollector) {
@@ -56,25 +119,65 @@ func NewBoshCollector(
 			processesFilter,
 			cidrsFilter,
 		)
-		enabledCollectors = append(enabledCollectors, serviceDiscoveryCollector)
+		deploymentsCollectors = append(deploymentsCollectors, serviceDiscoveryCollector)
+	}
+
+	if collectorsFilter.Enabled(filters.TasksCollector) {
+		tasksCollector := NewTasksCollector(namespace, environment, boshName, boshUUID, boshClient, recentTasksLimit)
+		directorCollectors = append(directorCollectors, tasksCollector)
 	}
 
 	metrics := NewBoshCollectorMetrics(namespace, environment, boshName, boshUUID)
 	return &BoshCollector{
-		enabledCollectors:                   enabledCollectors,
-		deploymentsFetcher:                  deploymentsFetcher,
-		totalBoshScrapesMetric:              metrics.NewTotalBoshScrapesMetric(),
-		totalBoshScrapeErrorsMetric:         metrics.NewTotalBoshScrapeErrorsMetric(),
-		lastBoshScrapeErrorMetric:           metrics.NewLastBoshScrapeErrorMetric(),
-		lastBoshScrapeTimestampMetric:       metrics.NewLastBoshScrapeTimestampMetric(),
-		lastBoshScrapeDurationSecondsMetric: metrics.NewLastBoshScrapeDurationSecondsMetric(),
+		directorCollectors:                        directorCollectors,
+		deploymentsCollectors:                     deploymentsCollectors,
+		deploymentsFetcher:                        deploymentsFetcher,
+		tokenSession:                              tokenSession,
+		totalBoshScrapesMetric:                    metrics.NewTotalBoshScrapesMetric(),
+		totalBoshScrapeErrorsMetric:               metrics.NewTotalBoshScrapeErrorsMetric(),
+		lastBoshScrapeErrorDesc:                   newDesc(metrics.NewLastBoshScrapeErrorMetric()),
+		lastBoshScrapeTimestampDesc:               newDesc(metrics.NewLastBoshScrapeTimestampMetric()),
+		lastBoshScrapeDurationSecondsDesc:         newDesc(metrics.NewLastBoshScrapeDurationSecondsMetric()),
+		deploymentsSnapshotAgeSecondsDesc:         newDesc(metrics.NewDeploymentsSnapshotAgeSecondsMetric()),
+		lastDeploymentsRefreshDurationSecondsDesc: newDesc(metrics.NewLastDeploymentsRefreshDurationSecondsMetric()),
+		releasesCacheHitsMetric: metrics.NewReleasesCacheHitsMetric(func() float64 {
+			hits, _ := deploymentsFetcher.ReleasesCacheStats()
+			return float64(hits)
+		}),
+		releasesCacheMissesMetric: metrics.NewReleasesCacheMissesMetric(func() float64 {
+			_, misses := deploymentsFetcher.ReleasesCacheStats()
+			return float64(misses)
+		}),
+		deploymentsFetchesInFlightMetric: metrics.NewDeploymentsFetchesInFlightMetric(func() float64 {
+			inFlight, _, _ := deploymentsFetcher.FetchStats()
+			return float64(inFlight)
+		}),
+		totalDeploymentsFetchesMetric: metrics.NewTotalDeploymentsFetchesMetric(func() float64 {
+			_, total, _ := deploymentsFetcher.FetchStats()
+			return float64(total)
+		}),
+		totalDeploymentsFetchQueueWaitSecondsMetric: metrics.NewTotalDeploymentsFetchQueueWaitSecondsMetric(func() float64 {
+			_, _, queueWait := deploymentsFetcher.FetchStats()
+			return queueWait.Seconds()
+		}),
+		lastDeploymentScrapeSuccessDesc:         newDesc(metrics.NewLastDeploymentScrapeSuccessMetric()),
+		lastDeploymentScrapeDurationSecondsDesc: newDesc(metrics.NewLastDeploymentScrapeDurationSecondsMetric()),
+		lastDeploymentScrapeTimedOutDesc:        newDesc(metrics.NewLastDeploymentScrapeTimedOutMetric()),
+		totalDeploymentScrapeErrorsDesc:         newDesc(metrics.NewTotalDeploymentScrapeErrorsMetric()),
+		uaaTokenExpiryTimestampDesc:             newDesc(metrics.NewUAATokenExpiryTimestampMetric()),
+		totalUAATokenRefreshFailuresMetric: metrics.NewTotalUAATokenRefreshFailuresMetric(func() float64 {
+			if tokenSession == nil {
+				return 0
+			}
+			return float64(tokenSession.RefreshFailures())
+		}),
 	}
 }
 
 func (c *BoshCollector) Describe(ch chan<- *prometheus.Desc) {
 	var wg = &sync.WaitGroup{}
 
-	for _, collector := range c.enabledCollectors {
+	for _, collector := range slices.Concat(c.directorCollectors, c.deploymentsCollectors) {
 		wg.Add(1)
 		go func(collector Collector, ch chan<- *prometheus.Desc) {
 			defer wg.Done()
@@ -85,69 +188,155 @@ func (c *BoshCollector) Describe(ch chan<- *prometheus.Desc) {
 
 	c.totalBoshScrapesMetric.Describe(ch)
 	c.totalBoshScrapeErrorsMetric.Describe(ch)
-	c.lastBoshScrapeErrorMetric.Describe(ch)
-	c.lastBoshScrapeTimestampMetric.Describe(ch)
-	c.lastBoshScrapeDurationSecondsMetric.Describe(ch)
+	ch <- c.lastBoshScrapeErrorDesc
+	ch <- c.lastBoshScrapeTimestampDesc
+	ch <- c.lastBoshScrapeDurationSecondsDesc
+	ch <- c.deploymentsSnapshotAgeSecondsDesc
+	ch <- c.lastDeploymentsRefreshDurationSecondsDesc
+	c.releasesCacheHitsMetric.Describe(ch)
+	c.releasesCacheMissesMetric.Describe(ch)
+	c.deploymentsFetchesInFlightMetric.Describe(ch)
+	c.totalDeploymentsFetchesMetric.Describe(ch)
+	c.totalDeploymentsFetchQueueWaitSecondsMetric.Describe(ch)
+	ch <- c.lastDeploymentScrapeSuccessDesc
+	ch <- c.lastDeploymentScrapeDurationSecondsDesc
+	ch <- c.lastDeploymentScrapeTimedOutDesc
+	ch <- c.totalDeploymentScrapeErrorsDesc
+	ch <- c.uaaTokenExpiryTimestampDesc
+	c.totalUAATokenRefreshFailuresMetric.Describe(ch)
+}
+
+// DirectorReachable tells whether the BOSH Director answered the last time its deployments were fetched.
+func (c *BoshCollector) DirectorReachable() bool {
+	return c.deploymentsFetcher.Reachable()
 }
 
 func (c *BoshCollector) Collect(ch chan<- prometheus.Metric) {
+	_ = c.CollectWithContext(context.Background(), ch)
+}
+
+// WithContext returns a prometheus.Collector that collects the BOSH metrics within the ctx of a single scrape.
+func (c *BoshCollector) WithContext(ctx context.Context) prometheus.Collector {
+	return &scrapeCollector{ctx: ctx, boshCollector: c}
+}
+
+// CollectWithContext collects the BOSH metrics, giving up on the deployments not read yet once ctx is done.
+// The metrics of the deployments read by then are still collected. It returns the errors of the scrape, also
+// reported by the last_scrape_error metric.
+func (c *BoshCollector) CollectWithContext(ctx context.Context, ch chan<- prometheus.Metric) error {
 	var begun = time.Now()
 
-	scrapeError := 0
+	var scrapeErr error
 	c.totalBoshScrapesMetric.Inc()
-	ds, err := c.deploymentsFetcher.Deployments()
+	ds, err := c.deploymentsFetcher.Deployments(ctx)
 	if err != nil {
 		log.Error(err)
+		scrapeErr = err
+	}
+
+	// the collectors of the deployments only run when some deployments were read, so that a failed fetch does not
+	// empty the Service Discovery file, whereas the collectors of the BOSH Director itself always run
+	collectors := c.directorCollectors
+	if err == nil || len(ds) > 0 {
+		collectors = slices.Concat(c.directorCollectors, c.deploymentsCollectors)
+	}
+	if err := c.executeCollectors(ctx, collectors, ds, ch); err != nil {
+		log.Error(err)
+		scrapeErr = errors.Join(scrapeErr, err)
+	}
+
+	scrapeError := 0
+	if scrapeErr != nil {
 		scrapeError = 1
 		c.totalBoshScrapeErrorsMetric.Inc()
-	} else {
-		if err := c.executeCollectors(ds, ch); err != nil {
-			log.Error(err)
-			scrapeError = 1
-			c.totalBoshScrapeErrorsMetric.Inc()
+	}
+
+	if refreshTimestamp, refreshDuration := c.deploymentsFetcher.LastRefresh(); !refreshTimestamp.IsZero() {
+		ch <- newGaugeMetric(c.deploymentsSnapshotAgeSecondsDesc, time.Since(refreshTimestamp).Seconds())
+		ch <- newGaugeMetric(c.lastDeploymentsRefreshDurationSecondsDesc, refreshDuration.Seconds())
+	}
+
+	c.releasesCacheHitsMetric.Collect(ch)
+	c.releasesCacheMissesMetric.Collect(ch)
+
+	c.deploymentsFetchesInFlightMetric.Collect(ch)
+	c.totalDeploymentsFetchesMetric.Collect(ch)
+	c.totalDeploymentsFetchQueueWaitSecondsMetric.Collect(ch)
+
+	c.reportDeploymentsScrapeMetrics(ch)
+
+	if c.tokenSession != nil {
+		if expiresAt := c.tokenSession.ExpiresAt(); !expiresAt.IsZero() {
+			ch <- newGaugeMetric(c.uaaTokenExpiryTimestampDesc, float64(expiresAt.Unix()))
 		}
+		c.totalUAATokenRefreshFailuresMetric.Collect(ch)
 	}
 
 	c.totalBoshScrapesMetric.Collect(ch)
 
 	c.totalBoshScrapeErrorsMetric.Collect(ch)
 
-	c.lastBoshScrapeErrorMetric.Set(float64(scrapeError))
-	c.lastBoshScrapeErrorMetric.Collect(ch)
+	ch <- newGaugeMetric(c.lastBoshScrapeErrorDesc, float64(scrapeError))
+	ch <- newGaugeMetric(c.lastBoshScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastBoshScrapeDurationSecondsDesc, time.Since(begun).Seconds())
 
-	c.lastBoshScrapeTimestampMetric.Set(float64(time.Now().Unix()))
-	c.lastBoshScrapeTimestampMetric.Collect(ch)
+	return scrapeErr
+}
+
+func (c *BoshCollector) reportDeploymentsScrapeMetrics(ch chan<- prometheus.Metric) {
+	for deploymentName, status := range c.deploymentsFetcher.DeploymentsFetchStatus() {
+		var successMetric float64
+		if status.Success {
+			successMetric = 1
+		}
+		ch <- newGaugeMetric(c.lastDeploymentScrapeSuccessDesc, successMetric, deploymentName)
+		ch <- newGaugeMetric(c.lastDeploymentScrapeDurationSecondsDesc, status.Duration.Seconds(), deploymentName)
 
-	c.lastBoshScrapeDurationSecondsMetric.Set(time.Since(begun).Seconds())
-	c.lastBoshScrapeDurationSecondsMetric.Collect(ch)
+		var timedOutMetric float64
+		if status.TimedOut {
+			timedOutMetric = 1
+		}
+		ch <- newGaugeMetric(c.lastDeploymentScrapeTimedOutDesc, timedOutMetric, deploymentName)
+
+		for _, phase := range deployments.FetchPhases {
+			ch <- prometheus.MustNewConstMetric(
+				c.totalDeploymentScrapeErrorsDesc,
+				prometheus.CounterValue,
+				float64(status.Errors[phase]),
+				deploymentName,
+				phase,
+			)
+		}
+	}
 }
 
-func (c *BoshCollector) executeCollectors(deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+// executeCollectors runs all enabled collectors concurrently within ctx and waits for every one of them to finish,
+// so none is still writing to ch once the scrape is over. The errors of all failed collectors are returned.
+func (c *BoshCollector) executeCollectors(ctx context.Context, collectors []Collector, deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
 	var wg = &sync.WaitGroup{}
 
-	doneChannel := make(chan bool, 1)
-	errChannel := make(chan error, 1)
-
-	for _, collector := range c.enabledCollectors {
+	errs := make([]error, len(collectors))
+	for i, collector := range collectors {
 		wg.Add(1)
-		go func(collector Collector) {
+		go func(i int, collector Collector) {
 			defer wg.Done()
-			if err := collector.Collect(deployments, ch); err != nil {
-				errChannel <- err
-			}
-		}(collector)
+			errs[i] = collector.Collect(ctx, deployments, ch)
+		}(i, collector)
 	}
+	wg.Wait()
 
-	go func() {
-		wg.Wait()
-		close(doneChannel)
-	}()
+	return errors.Join(errs...)
+}
 
-	select {
-	case <-doneChannel:
-	case err := <-errChannel:
-		return err
-	}
+type scrapeCollector struct {
+	ctx           context.Context
+	boshCollector *BoshCollector
+}
+
+func (c *scrapeCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.boshCollector.Describe(ch)
+}
 
-	return nil
+func (c *scrapeCollector) Collect(ch chan<- prometheus.Metric) {
+	_ = c.boshCollector.CollectWithContext(c.ctx, ch)
 }
@@ -104,3 +104,221 @@ func (m *BoshCollectorMetrics) NewTotalBoshScrapesMetric() prometheus.Counter {
 		},
 	)
 }
+
+func (m *BoshCollectorMetrics) NewDeploymentsSnapshotAgeSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "deployments_snapshot_age_seconds",
+			Help:      "Number of seconds since the BOSH deployments were last fetched from the BOSH Director.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *BoshCollectorMetrics) NewLastDeploymentsRefreshDurationSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_deployments_refresh_duration_seconds",
+			Help:      "Duration of the last fetch of the BOSH deployments from the BOSH Director.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *BoshCollectorMetrics) NewUAATokenExpiryTimestampMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "uaa_token_expiry_timestamp_seconds",
+			Help:      "Expiry time of the UAA access token used to authenticate to the BOSH Director, in seconds since 1970.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *BoshCollectorMetrics) NewTotalUAATokenRefreshFailuresMetric(function func() float64) prometheus.CounterFunc {
+	return prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "uaa_token_refresh_failures_total",
+			Help:      "Total number of times the UAA access token could not be renewed, with its refresh token or by requesting a new one.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		function,
+	)
+}
+
+func (m *BoshCollectorMetrics) NewReleasesCacheHitsMetric(function func() float64) prometheus.CounterFunc {
+	return prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "releases_cache_hits_total",
+			Help:      "Total number of BOSH release jobs and packages lookups served from the releases cache.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		function,
+	)
+}
+
+func (m *BoshCollectorMetrics) NewReleasesCacheMissesMetric(function func() float64) prometheus.CounterFunc {
+	return prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "releases_cache_misses_total",
+			Help:      "Total number of BOSH release jobs and packages lookups read from the BOSH Director.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		function,
+	)
+}
+
+func (m *BoshCollectorMetrics) NewDeploymentsFetchesInFlightMetric(function func() float64) prometheus.GaugeFunc {
+	return prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "deployments_fetches_in_flight",
+			Help:      "Number of BOSH deployments being fetched from the BOSH Director.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		function,
+	)
+}
+
+func (m *BoshCollectorMetrics) NewTotalDeploymentsFetchesMetric(function func() float64) prometheus.CounterFunc {
+	return prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "deployments_fetches_total",
+			Help:      "Total number of BOSH deployments fetched from the BOSH Director.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		function,
+	)
+}
+
+func (m *BoshCollectorMetrics) NewTotalDeploymentsFetchQueueWaitSecondsMetric(function func() float64) prometheus.CounterFunc {
+	return prometheus.NewCounterFunc(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "deployments_fetch_queue_wait_seconds_total",
+			Help:      "Total number of seconds BOSH deployments waited for a free fetch worker.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		function,
+	)
+}
+
+func (m *BoshCollectorMetrics) NewLastDeploymentScrapeSuccessMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_deployment_scrape_success",
+			Help:      "Whether the last scrape of the BOSH deployment succeeded (1 for success, 0 for error).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment"},
+	)
+}
+
+func (m *BoshCollectorMetrics) NewLastDeploymentScrapeDurationSecondsMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_deployment_scrape_duration_seconds",
+			Help:      "Duration of the last scrape of the BOSH deployment.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment"},
+	)
+}
+
+func (m *BoshCollectorMetrics) NewTotalDeploymentScrapeErrorsMetric() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "deployment_scrape_errors_total",
+			Help:      "Total number of times an error occurred scraping the BOSH deployment, by scrape phase.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "phase"},
+	)
+}
+
+func (m *BoshCollectorMetrics) NewLastDeploymentScrapeTimedOutMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_deployment_scrape_timed_out",
+			Help:      "Whether the last scrape of the BOSH deployment ran out of time (1 for timed out, 0 otherwise).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment"},
+	)
+}
@@ -1,8 +1,11 @@
 package collectors_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
@@ -18,6 +21,19 @@ import (
 	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
 )
 
+type fakeTokenSession struct {
+	expiresAt       time.Time
+	refreshFailures uint64
+}
+
+func (s *fakeTokenSession) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+func (s *fakeTokenSession) RefreshFailures() uint64 {
+	return s.refreshFailures
+}
+
 var _ = ginkgo.Describe("BoshCollector", func() {
 	var (
 		err                      error
@@ -27,11 +43,15 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 		boshUUID                 string
 		tmpfile                  *os.File
 		serviceDiscoveryFilename string
+		recentTasksLimit         int
+		infoRefreshInterval      time.Duration
 
 		boshDeployments    []string
 		boshClient         *directorfakes.FakeDirector
 		deploymentsFilter  *filters.DeploymentsFilter
-		deploymentsFetcher *deployments.Fetcher
+		fetchWorkers       int
+		deploymentsFetcher collectors.DeploymentsFetcher
+		tokenSession       collectors.TokenSession
 		collectorsFilter   *filters.CollectorsFilter
 		azsFilter          *filters.AZsFilter
 		processesFilter    *filters.RegexpFilter
@@ -39,11 +59,24 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 		metrics            *collectors.BoshCollectorMetrics
 		boshCollector      *collectors.BoshCollector
 
-		totalBoshScrapesMetric              prometheus.Counter
-		totalBoshScrapeErrorsMetric         prometheus.Counter
-		lastBoshScrapeErrorMetric           prometheus.Gauge
-		lastBoshScrapeTimestampMetric       prometheus.Gauge
-		lastBoshScrapeDurationSecondsMetric prometheus.Gauge
+		totalBoshScrapesMetric                      prometheus.Counter
+		totalBoshScrapeErrorsMetric                 prometheus.Counter
+		lastBoshScrapeErrorMetric                   prometheus.Gauge
+		lastBoshScrapeTimestampMetric               prometheus.Gauge
+		lastBoshScrapeDurationSecondsMetric         prometheus.Gauge
+		deploymentsSnapshotAgeSecondsMetric         prometheus.Gauge
+		lastDeploymentsRefreshDurationSecondsMetric prometheus.Gauge
+		releasesCacheHitsMetric                     prometheus.CounterFunc
+		releasesCacheMissesMetric                   prometheus.CounterFunc
+		deploymentsFetchesInFlightMetric            prometheus.GaugeFunc
+		totalDeploymentsFetchesMetric               prometheus.CounterFunc
+		totalDeploymentsFetchQueueWaitSecondsMetric prometheus.CounterFunc
+		lastDeploymentScrapeSuccessMetric           *prometheus.GaugeVec
+		lastDeploymentScrapeDurationSecondsMetric   *prometheus.GaugeVec
+		lastDeploymentScrapeTimedOutMetric          *prometheus.GaugeVec
+		totalDeploymentScrapeErrorsMetric           *prometheus.CounterVec
+		uaaTokenExpiryTimestampMetric               prometheus.Gauge
+		totalUAATokenRefreshFailuresMetric          prometheus.CounterFunc
 	)
 
 	ginkgo.BeforeEach(func() {
@@ -55,12 +88,25 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 		tmpfile, err = os.CreateTemp("", "service_discovery_collector_test_")
 		gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		serviceDiscoveryFilename = tmpfile.Name()
+		recentTasksLimit = 10
+		infoRefreshInterval = 5 * time.Minute
 
 		boshDeployments = []string{}
 		boshClient = &directorfakes.FakeDirector{}
 		deploymentsFilter = filters.NewDeploymentsFilter(boshDeployments, boshClient)
-		deploymentsFetcher = deployments.NewFetcher(*deploymentsFilter)
-		collectorsFilter, err = filters.NewCollectorsFilter([]string{})
+		fetchWorkers = 10
+		deploymentsFetcher = deployments.NewFetcher(*deploymentsFilter, fetchWorkers)
+		tokenSession = nil
+		collectorsFilter, err = filters.NewCollectorsFilter([]string{
+			filters.CertificatesCollector,
+			filters.DeploymentsCollector,
+			filters.DirectorCollector,
+			filters.EventsCollector,
+			filters.JobsCollector,
+			filters.OrphansCollector,
+			filters.ServiceDiscoveryCollector,
+			filters.TasksCollector,
+		})
 		gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		azsFilter = filters.NewAZsFilter([]string{})
 		cidrsFilter, err = filters.NewCidrFilter([]string{})
@@ -75,6 +121,19 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 		lastBoshScrapeErrorMetric.Set(float64(0))
 		lastBoshScrapeTimestampMetric = metrics.NewLastBoshScrapeTimestampMetric()
 		lastBoshScrapeDurationSecondsMetric = metrics.NewLastBoshScrapeDurationSecondsMetric()
+		deploymentsSnapshotAgeSecondsMetric = metrics.NewDeploymentsSnapshotAgeSecondsMetric()
+		lastDeploymentsRefreshDurationSecondsMetric = metrics.NewLastDeploymentsRefreshDurationSecondsMetric()
+		releasesCacheHitsMetric = metrics.NewReleasesCacheHitsMetric(func() float64 { return 0 })
+		releasesCacheMissesMetric = metrics.NewReleasesCacheMissesMetric(func() float64 { return 0 })
+		deploymentsFetchesInFlightMetric = metrics.NewDeploymentsFetchesInFlightMetric(func() float64 { return 0 })
+		totalDeploymentsFetchesMetric = metrics.NewTotalDeploymentsFetchesMetric(func() float64 { return 0 })
+		totalDeploymentsFetchQueueWaitSecondsMetric = metrics.NewTotalDeploymentsFetchQueueWaitSecondsMetric(func() float64 { return 0 })
+		lastDeploymentScrapeSuccessMetric = metrics.NewLastDeploymentScrapeSuccessMetric()
+		lastDeploymentScrapeDurationSecondsMetric = metrics.NewLastDeploymentScrapeDurationSecondsMetric()
+		lastDeploymentScrapeTimedOutMetric = metrics.NewLastDeploymentScrapeTimedOutMetric()
+		totalDeploymentScrapeErrorsMetric = metrics.NewTotalDeploymentScrapeErrorsMetric()
+		uaaTokenExpiryTimestampMetric = metrics.NewUAATokenExpiryTimestampMetric()
+		totalUAATokenRefreshFailuresMetric = metrics.NewTotalUAATokenRefreshFailuresMetric(func() float64 { return 0 })
 	})
 
 	ginkgo.AfterEach(func() {
@@ -90,6 +149,10 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 			boshUUID,
 			serviceDiscoveryFilename,
 			deploymentsFetcher,
+			boshClient,
+			tokenSession,
+			recentTasksLimit,
+			infoRefreshInterval,
 			collectorsFilter,
 			azsFilter,
 			processesFilter,
@@ -129,19 +192,78 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 		ginkgo.It("returns a last_scrape_duration_seconds metric description", func() {
 			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastBoshScrapeDurationSecondsMetric.Desc())))
 		})
+
+		ginkgo.It("returns a deployments_snapshot_age_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(deploymentsSnapshotAgeSecondsMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_deployments_refresh_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastDeploymentsRefreshDurationSecondsMetric.Desc())))
+		})
+
+		ginkgo.It("returns a releases_cache_hits_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(releasesCacheHitsMetric.Desc())))
+		})
+
+		ginkgo.It("returns a releases_cache_misses_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(releasesCacheMissesMetric.Desc())))
+		})
+
+		ginkgo.It("returns a deployments_fetches_in_flight metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(deploymentsFetchesInFlightMetric.Desc())))
+		})
+
+		ginkgo.It("returns a deployments_fetches_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(totalDeploymentsFetchesMetric.Desc())))
+		})
+
+		ginkgo.It("returns a deployments_fetch_queue_wait_seconds_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(totalDeploymentsFetchQueueWaitSecondsMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_deployment_scrape_success metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastDeploymentScrapeSuccessMetric.WithLabelValues("fake-deployment-name").Desc())))
+		})
+
+		ginkgo.It("returns a last_deployment_scrape_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastDeploymentScrapeDurationSecondsMetric.WithLabelValues("fake-deployment-name").Desc())))
+		})
+
+		ginkgo.It("returns a last_deployment_scrape_timed_out metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastDeploymentScrapeTimedOutMetric.WithLabelValues("fake-deployment-name").Desc())))
+		})
+
+		ginkgo.It("returns a deployment_scrape_errors_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(totalDeploymentScrapeErrorsMetric.WithLabelValues("fake-deployment-name", deployments.InstancesFetchPhase).Desc())))
+		})
+
+		ginkgo.It("returns a uaa_token_expiry_timestamp_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(uaaTokenExpiryTimestampMetric.Desc())))
+		})
+
+		ginkgo.It("returns a uaa_token_refresh_failures_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(totalUAATokenRefreshFailuresMetric.Desc())))
+		})
 	})
 
 	ginkgo.Describe("Collect", func() {
 		var (
+			ctx     context.Context
+			cancel  context.CancelFunc
 			metrics chan prometheus.Metric
 		)
 
 		ginkgo.BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
 			metrics = make(chan prometheus.Metric)
 		})
 
+		ginkgo.AfterEach(func() {
+			cancel()
+		})
+
 		ginkgo.JustBeforeEach(func() {
-			go boshCollector.Collect(metrics)
+			go boshCollector.WithContext(ctx).Collect(metrics)
 		})
 
 		ginkgo.It("returns a scrapes_total metric", func() {
@@ -156,6 +278,208 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(lastBoshScrapeErrorMetric)))
 		})
 
+		ginkgo.It("returns a deployments_snapshot_age_seconds metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(gomega.WithTransform(func(metric prometheus.Metric) *prometheus.Desc {
+				return metric.Desc()
+			}, gomega.Equal(deploymentsSnapshotAgeSecondsMetric.Desc()))))
+		})
+
+		ginkgo.It("returns a last_deployments_refresh_duration_seconds metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(gomega.WithTransform(func(metric prometheus.Metric) *prometheus.Desc {
+				return metric.Desc()
+			}, gomega.Equal(lastDeploymentsRefreshDurationSecondsMetric.Desc()))))
+		})
+
+		ginkgo.It("returns a releases_cache_hits_total metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(releasesCacheHitsMetric)))
+		})
+
+		ginkgo.It("returns a releases_cache_misses_total metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(releasesCacheMissesMetric)))
+		})
+
+		ginkgo.It("returns a deployments_fetches_in_flight metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(deploymentsFetchesInFlightMetric)))
+		})
+
+		ginkgo.It("returns a deployments_fetches_total metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalDeploymentsFetchesMetric)))
+		})
+
+		ginkgo.It("returns a deployments_fetch_queue_wait_seconds_total metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalDeploymentsFetchQueueWaitSecondsMetric)))
+		})
+
+		ginkgo.It("does not return an error", func() {
+			collected := make(chan prometheus.Metric, 1000)
+			gomega.Expect(boshCollector.CollectWithContext(context.Background(), collected)).To(gomega.Succeed())
+		})
+
+		ginkgo.Context("when it fails to scrape a deployment", func() {
+			var (
+				deploymentName = "fake-deployment-name"
+			)
+
+			ginkgo.BeforeEach(func() {
+				deployment := &directorfakes.FakeDeployment{}
+				deployment.NameReturns(deploymentName)
+				deployment.InstanceInfosReturns(nil, errors.New("no instances"))
+				boshClient.DeploymentsReturns([]director.Deployment{deployment}, nil)
+
+				lastDeploymentScrapeSuccessMetric.WithLabelValues(deploymentName).Set(float64(0))
+				lastDeploymentScrapeTimedOutMetric.WithLabelValues(deploymentName).Set(float64(0))
+				totalDeploymentScrapeErrorsMetric.WithLabelValues(deploymentName, deployments.InstancesFetchPhase).Inc()
+			})
+
+			ginkgo.It("returns a last_deployment_scrape_timed_out metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(lastDeploymentScrapeTimedOutMetric.WithLabelValues(deploymentName))))
+			})
+
+			ginkgo.It("returns a last_deployment_scrape_success metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(lastDeploymentScrapeSuccessMetric.WithLabelValues(deploymentName))))
+			})
+
+			ginkgo.It("returns a deployment_scrape_errors_total metric for the failed phase", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalDeploymentScrapeErrorsMetric.WithLabelValues(
+					deploymentName,
+					deployments.InstancesFetchPhase,
+				))))
+			})
+
+			ginkgo.It("returns a deployment_scrape_errors_total metric for the other phases", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalDeploymentScrapeErrorsMetric.WithLabelValues(
+					deploymentName,
+					deployments.StemcellsFetchPhase,
+				))))
+			})
+		})
+
+		ginkgo.Context("when the scrape context is done", func() {
+			ginkgo.BeforeEach(func() {
+				cancel()
+
+				totalBoshScrapeErrorsMetric.Inc()
+				lastBoshScrapeErrorMetric.Set(float64(1))
+			})
+
+			ginkgo.It("does not read the deployments", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(lastBoshScrapeErrorMetric)))
+				gomega.Expect(boshClient.DeploymentsCallCount()).To(gomega.Equal(0))
+			})
+
+			ginkgo.It("returns a scrape_errors_total metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalBoshScrapeErrorsMetric)))
+			})
+		})
+
+		ginkgo.Context("when several collectors fail", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.InfoReturns(director.Info{}, errors.New("no info"))
+				boshClient.OrphanDisksReturns(nil, errors.New("no orphaned disks"))
+
+				totalBoshScrapeErrorsMetric.Inc()
+				lastBoshScrapeErrorMetric.Set(float64(1))
+			})
+
+			ginkgo.It("returns a scrape_errors_total metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalBoshScrapeErrorsMetric)))
+			})
+
+			ginkgo.It("returns a last_scrape_error metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(lastBoshScrapeErrorMetric)))
+			})
+
+			ginkgo.It("returns the metrics of every collector before the scrape ends", func() {
+				collected := make(chan prometheus.Metric, 1000)
+				boshCollector.Collect(collected)
+				close(collected)
+
+				var fqNames []string
+				for metric := range collected {
+					fqNames = append(fqNames, metric.Desc().String())
+				}
+				for _, collector := range []string{"certificates", "deployments", "director", "events", "jobs", "orphans", "service_discovery", "tasks"} {
+					gomega.Expect(fqNames).To(gomega.ContainElement(gomega.ContainSubstring(
+						fmt.Sprintf(`fqName: "%s_last_%s_scrape_timestamp"`, testNamespace, collector),
+					)))
+				}
+			})
+		})
+
+		ginkgo.Context("when authenticating with UAA access tokens", func() {
+			var tokenExpiry = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			ginkgo.BeforeEach(func() {
+				tokenSession = &fakeTokenSession{expiresAt: tokenExpiry, refreshFailures: 2}
+
+				uaaTokenExpiryTimestampMetric.Set(float64(tokenExpiry.Unix()))
+				totalUAATokenRefreshFailuresMetric = collectors.NewBoshCollectorMetrics(
+					testNamespace,
+					testEnvironment,
+					testBoshName,
+					testBoshUUID,
+				).NewTotalUAATokenRefreshFailuresMetric(func() float64 { return 2 })
+			})
+
+			ginkgo.It("returns a uaa_token_expiry_timestamp_seconds metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(uaaTokenExpiryTimestampMetric)))
+			})
+
+			ginkgo.It("returns a uaa_token_refresh_failures_total metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(totalUAATokenRefreshFailuresMetric)))
+			})
+		})
+
+		ginkgo.Context("when not authenticating with UAA access tokens", func() {
+			ginkgo.It("does not return UAA token metrics", func() {
+				collected := make(chan prometheus.Metric, 1000)
+				boshCollector.Collect(collected)
+				close(collected)
+
+				for metric := range collected {
+					gomega.Expect(metric.Desc().String()).ToNot(gomega.ContainSubstring("uaa_token"))
+				}
+			})
+		})
+
+		ginkgo.Context("when the deployments are replayed from a snapshot", func() {
+			var snapshotFilename string
+
+			ginkgo.BeforeEach(func() {
+				snapshotFilename = serviceDiscoveryFilename + ".snapshot.json"
+				gomega.Expect(deployments.WriteSnapshotFile(snapshotFilename, []deployments.DeploymentInfo{
+					{
+						Name:      "fake-deployment-name",
+						Stemcells: []deployments.Stemcell{{Name: "fake-stemcell-name", Version: "fake-stemcell-version"}},
+					},
+				})).To(gomega.Succeed())
+
+				deploymentsFetcher, err = deployments.NewSnapshotSource(snapshotFilename, *filters.NewDeploymentsFilter(nil, nil))
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				collectorsFilter, err = filters.NewCollectorsFilter([]string{filters.DeploymentsCollector})
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			})
+
+			ginkgo.AfterEach(func() {
+				gomega.Expect(os.Remove(snapshotFilename)).To(gomega.Succeed())
+			})
+
+			ginkgo.It("returns the metrics of the recorded deployments without reading the BOSH Director", func() {
+				collected := make(chan prometheus.Metric, 1000)
+				boshCollector.Collect(collected)
+				close(collected)
+
+				var fqNames []string
+				for metric := range collected {
+					fqNames = append(fqNames, metric.Desc().String())
+				}
+				gomega.Expect(fqNames).To(gomega.ContainElement(gomega.ContainSubstring(
+					fmt.Sprintf(`fqName: "%s_deployment_stemcell_info"`, testNamespace),
+				)))
+				gomega.Expect(boshClient.DeploymentsCallCount()).To(gomega.Equal(0))
+			})
+		})
+
 		ginkgo.Context("when it fails to get the deployment", func() {
 			ginkgo.BeforeEach(func() {
 				boshClient.DeploymentsReturns([]director.Deployment{}, errors.New("no deployments"))
@@ -171,6 +495,32 @@ var _ = ginkgo.Describe("BoshCollector", func() {
 			ginkgo.It("returns a last_scrape_error metric", func() {
 				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(lastBoshScrapeErrorMetric)))
 			})
+
+			ginkgo.It("returns the error of the scrape", func() {
+				collected := make(chan prometheus.Metric, 1000)
+				gomega.Expect(boshCollector.CollectWithContext(context.Background(), collected)).To(gomega.MatchError(gomega.ContainSubstring("no deployments")))
+			})
+
+			ginkgo.It("returns the metrics of the BOSH Director collectors only", func() {
+				collected := make(chan prometheus.Metric, 1000)
+				boshCollector.Collect(collected)
+				close(collected)
+
+				var fqNames []string
+				for metric := range collected {
+					fqNames = append(fqNames, metric.Desc().String())
+				}
+				for _, collector := range []string{"certificates", "director", "events", "orphans", "tasks"} {
+					gomega.Expect(fqNames).To(gomega.ContainElement(gomega.ContainSubstring(
+						fmt.Sprintf(`fqName: "%s_last_%s_scrape_timestamp"`, testNamespace, collector),
+					)))
+				}
+				for _, collector := range []string{"deployments", "jobs", "service_discovery"} {
+					gomega.Expect(fqNames).ToNot(gomega.ContainElement(gomega.ContainSubstring(
+						fmt.Sprintf(`fqName: "%s_last_%s_scrape_timestamp"`, testNamespace, collector),
+					)))
+				}
+			})
 		})
 	})
 })
@@ -0,0 +1,101 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
+)
+
+// certificateExpiryNotSupported is the error returned by the BOSH CLI when the Director
+// does not implement the certificate expiry endpoint.
+const certificateExpiryNotSupported = "Certificate expiry information not supported"
+
+type CertificatesCollector struct {
+	boshClient                                director.Director
+	directorCertificateDaysLeftDesc           *prometheus.Desc
+	directorCertificateExpiryTimestampDesc    *prometheus.Desc
+	lastCertificatesScrapeTimestampDesc       *prometheus.Desc
+	lastCertificatesScrapeDurationSecondsDesc *prometheus.Desc
+}
+
+func NewCertificatesCollector(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+	boshClient director.Director,
+) *CertificatesCollector {
+	metrics := NewCertificatesCollectorMetrics(namespace, environment, boshName, boshUUID)
+	collector := &CertificatesCollector{
+		boshClient:                                boshClient,
+		directorCertificateDaysLeftDesc:           newDesc(metrics.NewDirectorCertificateDaysLeftMetric()),
+		directorCertificateExpiryTimestampDesc:    newDesc(metrics.NewDirectorCertificateExpiryTimestampMetric()),
+		lastCertificatesScrapeTimestampDesc:       newDesc(metrics.NewLastCertificatesScrapeTimestampMetric()),
+		lastCertificatesScrapeDurationSecondsDesc: newDesc(metrics.NewLastCertificatesScrapeDurationSecondsMetric()),
+	}
+	return collector
+}
+
+func (c *CertificatesCollector) Collect(ctx context.Context, _ []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+	var begun = time.Now()
+
+	certificates, err := c.fetchCertificates(ctx)
+	if err == nil {
+		c.reportCertificatesMetrics(certificates, ch)
+	}
+
+	ch <- newGaugeMetric(c.lastCertificatesScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastCertificatesScrapeDurationSecondsDesc, time.Since(begun).Seconds())
+
+	return err
+}
+
+func (c *CertificatesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.directorCertificateDaysLeftDesc
+	ch <- c.directorCertificateExpiryTimestampDesc
+	ch <- c.lastCertificatesScrapeTimestampDesc
+	ch <- c.lastCertificatesScrapeDurationSecondsDesc
+}
+
+func (c *CertificatesCollector) fetchCertificates(ctx context.Context) ([]director.CertificateExpiryInfo, error) {
+	certificates, err := ctxcall.Do(ctx, func() (certificates []director.CertificateExpiryInfo, err error) {
+		// the BOSH CLI dereferences a nil response when the request itself fails
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v", r)
+			}
+		}()
+
+		return c.boshClient.CertificateExpiry()
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), certificateExpiryNotSupported) {
+			log.Debugf("BOSH Director does not support certificate expiry information")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error while reading certificate expiry: %v", err)
+	}
+
+	return certificates, nil
+}
+
+func (c *CertificatesCollector) reportCertificatesMetrics(certificates []director.CertificateExpiryInfo, ch chan<- prometheus.Metric) {
+	for _, certificate := range certificates {
+		ch <- newGaugeMetric(c.directorCertificateDaysLeftDesc, float64(certificate.DaysLeft), certificate.Path)
+
+		expiry, err := time.Parse(time.RFC3339, certificate.Expiry)
+		if err != nil {
+			log.Debugf("Unable to parse expiry `%s` of certificate `%s`: %v", certificate.Expiry, certificate.Path, err)
+			continue
+		}
+		ch <- newGaugeMetric(c.directorCertificateExpiryTimestampDesc, float64(expiry.Unix()), certificate.Path)
+	}
+}
@@ -0,0 +1,92 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type CertificatesCollectorMetrics struct {
+	namespace   string
+	environment string
+	boshName    string
+	boshUUID    string
+}
+
+func NewCertificatesCollectorMetrics(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+) *CertificatesCollectorMetrics {
+	return &CertificatesCollectorMetrics{
+		namespace:   namespace,
+		environment: environment,
+		boshName:    boshName,
+		boshUUID:    boshUUID,
+	}
+}
+
+func (m *CertificatesCollectorMetrics) NewLastCertificatesScrapeDurationSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_certificates_scrape_duration_seconds",
+			Help:      "Duration of the last scrape of Certificates metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *CertificatesCollectorMetrics) NewLastCertificatesScrapeTimestampMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_certificates_scrape_timestamp",
+			Help:      "Number of seconds since 1970 since last scrape of Certificates metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *CertificatesCollectorMetrics) NewDirectorCertificateExpiryTimestampMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "director",
+			Name:      "certificate_expiry_timestamp",
+			Help:      "Number of seconds since 1970 when the BOSH Director certificate expires.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_certificate_path"},
+	)
+}
+
+func (m *CertificatesCollectorMetrics) NewDirectorCertificateDaysLeftMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "director",
+			Name:      "certificate_days_left",
+			Help:      "Number of days left before the BOSH Director certificate expires.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_certificate_path"},
+	)
+}
@@ -0,0 +1,187 @@
+package collectors_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/cloudfoundry/bosh-cli/director/directorfakes"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
+)
+
+var _ = ginkgo.Describe("CertificatesCollector", func() {
+	var (
+		namespace             string
+		environment           string
+		boshName              string
+		boshUUID              string
+		boshClient            *directorfakes.FakeDirector
+		metrics               *collectors.CertificatesCollectorMetrics
+		certificatesCollector *collectors.CertificatesCollector
+
+		directorCertificateDaysLeftMetric           *prometheus.GaugeVec
+		directorCertificateExpiryTimestampMetric    *prometheus.GaugeVec
+		lastCertificatesScrapeTimestampMetric       prometheus.Gauge
+		lastCertificatesScrapeDurationSecondsMetric prometheus.Gauge
+
+		certificatePath     = "director.ssl.cert"
+		certificateExpiry   = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		certificateDaysLeft = 42
+	)
+
+	ginkgo.BeforeEach(func() {
+		namespace = testNamespace
+		environment = testEnvironment
+		boshName = testBoshName
+		boshUUID = testBoshUUID
+		boshClient = &directorfakes.FakeDirector{}
+		metrics = collectors.NewCertificatesCollectorMetrics(testNamespace, testEnvironment, testBoshName, testBoshUUID)
+
+		directorCertificateDaysLeftMetric = metrics.NewDirectorCertificateDaysLeftMetric()
+		directorCertificateDaysLeftMetric.WithLabelValues(certificatePath).Set(float64(certificateDaysLeft))
+
+		directorCertificateExpiryTimestampMetric = metrics.NewDirectorCertificateExpiryTimestampMetric()
+		directorCertificateExpiryTimestampMetric.WithLabelValues(certificatePath).Set(float64(certificateExpiry.Unix()))
+
+		lastCertificatesScrapeTimestampMetric = metrics.NewLastCertificatesScrapeTimestampMetric()
+		lastCertificatesScrapeDurationSecondsMetric = metrics.NewLastCertificatesScrapeDurationSecondsMetric()
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		certificatesCollector = collectors.NewCertificatesCollector(namespace, environment, boshName, boshUUID, boshClient)
+	})
+
+	ginkgo.Describe("Describe", func() {
+		var (
+			descriptions chan *prometheus.Desc
+		)
+
+		ginkgo.BeforeEach(func() {
+			descriptions = make(chan *prometheus.Desc)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			go certificatesCollector.Describe(descriptions)
+		})
+
+		ginkgo.It("returns a director_certificate_days_left metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(directorCertificateDaysLeftMetric.WithLabelValues(certificatePath).Desc())))
+		})
+
+		ginkgo.It("returns a director_certificate_expiry_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(directorCertificateExpiryTimestampMetric.WithLabelValues(certificatePath).Desc())))
+		})
+
+		ginkgo.It("returns a last_certificates_scrape_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastCertificatesScrapeTimestampMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_certificates_scrape_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastCertificatesScrapeDurationSecondsMetric.Desc())))
+		})
+	})
+
+	ginkgo.Describe("Collect", func() {
+		var (
+			metrics    chan prometheus.Metric
+			errMetrics chan error
+			ctx        context.Context
+		)
+
+		ginkgo.BeforeEach(func() {
+			boshClient.CertificateExpiryReturns([]director.CertificateExpiryInfo{
+				{
+					Path:     certificatePath,
+					Expiry:   certificateExpiry.Format(time.RFC3339),
+					DaysLeft: certificateDaysLeft,
+				},
+			}, nil)
+
+			ctx = context.Background()
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			metrics, errMetrics = collectInBackground(ctx, certificatesCollector, []deployments.DeploymentInfo{})
+		})
+
+		ginkgo.It("returns a director_certificate_days_left metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorCertificateDaysLeftMetric.WithLabelValues(certificatePath))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a director_certificate_expiry_timestamp metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorCertificateExpiryTimestampMetric.WithLabelValues(certificatePath))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when the expiry cannot be parsed", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.CertificateExpiryReturns([]director.CertificateExpiryInfo{
+					{Path: certificatePath, Expiry: "not-a-date", DaysLeft: certificateDaysLeft},
+				}, nil)
+			})
+
+			ginkgo.It("does not return a director_certificate_expiry_timestamp metric", func() {
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive(matchers.PrometheusMetric(directorCertificateExpiryTimestampMetric.WithLabelValues(certificatePath))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.Context("when the director does not support certificate expiry", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.CertificateExpiryReturns(nil, errors.New("Certificate expiry information not supported: Director responded with non-successful status code '404'"))
+			})
+
+			ginkgo.It("returns only a last_certificates_scrape_timestamp & last_certificates_scrape_duration_seconds metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive())
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.Context("when the BOSH Director does not answer before the scrape times out", func() {
+			var cancel context.CancelFunc
+
+			ginkgo.BeforeEach(func() {
+				ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+				hang := hangingCall()
+				boshClient.CertificateExpiryStub = func() ([]director.CertificateExpiryInfo, error) {
+					hang()
+					return nil, nil
+				}
+			})
+
+			ginkgo.AfterEach(func() {
+				cancel()
+			})
+
+			ginkgo.It("returns a timeout error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive(gomega.MatchError(gomega.ContainSubstring(context.DeadlineExceeded.Error()))))
+			})
+		})
+
+		ginkgo.Context("when it fails to get the certificate expiry", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.CertificateExpiryReturns(nil, errors.New("Getting certificate expiry endpoint error"))
+			})
+
+			ginkgo.It("returns an error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive())
+			})
+		})
+	})
+})
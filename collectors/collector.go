@@ -1,12 +1,28 @@
 package collectors
 
 import (
+	"context"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/cloudfoundry/bosh_exporter/deployments"
 )
 
 type Collector interface {
-	Collect(deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error
+	Collect(ctx context.Context, deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error
 	Describe(ch chan<- *prometheus.Desc)
 }
+
+// newDesc returns the descriptor of a metric built by one of the *CollectorMetrics factories, which remain the
+// single definition of each metric name, help and labels.
+func newDesc(metric prometheus.Collector) *prometheus.Desc {
+	descs := make(chan *prometheus.Desc, 1)
+	metric.Describe(descs)
+	return <-descs
+}
+
+// newGaugeMetric builds an immutable gauge sample for the current scrape. Collectors never keep metric values
+// between scrapes, so concurrent scrapes cannot interleave and return partial or duplicated series.
+func newGaugeMetric(desc *prometheus.Desc, value float64, labelValues ...string) prometheus.Metric {
+	return prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labelValues...)
+}
@@ -1,8 +1,15 @@
 package collectors_test
 
 import (
+	"context"
+	"sync"
+
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/deployments"
 
 	"testing"
 )
@@ -18,3 +25,65 @@ func TestCollectors(t *testing.T) {
 	gomega.RegisterFailHandler(ginkgo.AbortSuite)
 	ginkgo.RunSpecs(t, "Collectors Suite")
 }
+
+// collectInBackground calls collector.Collect in the background, as a scrape does, and returns the channels its
+// metrics and its error are sent to. When the spec is over, the context of the call is cancelled, and its metrics are
+// drained until it returns, so that it never outlives the spec.
+func collectInBackground(ctx context.Context, collector collectors.Collector, deploymentsInfo []deployments.DeploymentInfo) (chan prometheus.Metric, chan error) {
+	ctx, cancel := context.WithCancel(ctx)
+	metrics := make(chan prometheus.Metric)
+	errMetrics := make(chan error, 1)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		if err := collector.Collect(ctx, deploymentsInfo, metrics); err != nil {
+			errMetrics <- err
+		}
+	}()
+
+	ginkgo.DeferCleanup(func() {
+		cancel()
+		for {
+			select {
+			case <-metrics:
+			case <-done:
+				return
+			}
+		}
+	})
+
+	return metrics, errMetrics
+}
+
+// hangingCall returns the function the stubs of the fake BOSH Director calls that do not answer before the scrape
+// times out block in. When the spec is over, the blocked calls are released and waited for, and the calls still left
+// return at once.
+func hangingCall() func() {
+	var (
+		mu       sync.Mutex
+		released bool
+		calls    sync.WaitGroup
+	)
+	hung := make(chan struct{})
+
+	ginkgo.DeferCleanup(func() {
+		mu.Lock()
+		released = true
+		mu.Unlock()
+		close(hung)
+		calls.Wait()
+	})
+
+	return func() {
+		mu.Lock()
+		if released {
+			mu.Unlock()
+			return
+		}
+		calls.Add(1)
+		mu.Unlock()
+		defer calls.Done()
+		<-hung
+	}
+}
@@ -1,6 +1,7 @@
 package collectors
 
 import (
+	"context"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -9,13 +10,13 @@ import (
 )
 
 type DeploymentsCollector struct {
-	deploymentReleaseInfoMetric                *prometheus.GaugeVec
-	deploymentReleaseJobInfoMetric             *prometheus.GaugeVec
-	deploymentReleasePackageInfoMetric         *prometheus.GaugeVec
-	deploymentStemcellInfoMetric               *prometheus.GaugeVec
-	deploymentInstancesMetric                  *prometheus.GaugeVec
-	lastDeploymentsScrapeTimestampMetric       prometheus.Gauge
-	lastDeploymentsScrapeDurationSecondsMetric prometheus.Gauge
+	deploymentReleaseInfoDesc                *prometheus.Desc
+	deploymentReleaseJobInfoDesc             *prometheus.Desc
+	deploymentReleasePackageInfoDesc         *prometheus.Desc
+	deploymentStemcellInfoDesc               *prometheus.Desc
+	deploymentInstancesDesc                  *prometheus.Desc
+	lastDeploymentsScrapeTimestampDesc       *prometheus.Desc
+	lastDeploymentsScrapeDurationSecondsDesc *prometheus.Desc
 }
 
 func NewDeploymentsCollector(
@@ -26,105 +27,110 @@ func NewDeploymentsCollector(
 ) *DeploymentsCollector {
 	metrics := NewDeploymentsCollectorMetrics(namespace, environment, boshName, boshUUID)
 	collector := &DeploymentsCollector{
-		deploymentReleaseInfoMetric:                metrics.NewDeploymentReleaseInfoMetric(),
-		deploymentReleaseJobInfoMetric:             metrics.NewDeploymentReleaseJobInfoMetric(),
-		deploymentReleasePackageInfoMetric:         metrics.NewDeploymentReleasePackageInfoMetric(),
-		deploymentStemcellInfoMetric:               metrics.NewDeploymentStemcellInfoMetric(),
-		deploymentInstancesMetric:                  metrics.NewDeploymentInstancesMetric(),
-		lastDeploymentsScrapeTimestampMetric:       metrics.NewLastDeploymentsScrapeTimestampMetric(),
-		lastDeploymentsScrapeDurationSecondsMetric: metrics.NewLastDeploymentsScrapeDurationSecondsMetric(),
+		deploymentReleaseInfoDesc:                newDesc(metrics.NewDeploymentReleaseInfoMetric()),
+		deploymentReleaseJobInfoDesc:             newDesc(metrics.NewDeploymentReleaseJobInfoMetric()),
+		deploymentReleasePackageInfoDesc:         newDesc(metrics.NewDeploymentReleasePackageInfoMetric()),
+		deploymentStemcellInfoDesc:               newDesc(metrics.NewDeploymentStemcellInfoMetric()),
+		deploymentInstancesDesc:                  newDesc(metrics.NewDeploymentInstancesMetric()),
+		lastDeploymentsScrapeTimestampDesc:       newDesc(metrics.NewLastDeploymentsScrapeTimestampMetric()),
+		lastDeploymentsScrapeDurationSecondsDesc: newDesc(metrics.NewLastDeploymentsScrapeDurationSecondsMetric()),
 	}
 	return collector
 }
 
-func (c *DeploymentsCollector) Collect(deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+func (c *DeploymentsCollector) Collect(_ context.Context, deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
 	var begun = time.Now()
 
-	c.deploymentReleaseInfoMetric.Reset()
-	c.deploymentReleaseJobInfoMetric.Reset()
-	c.deploymentReleasePackageInfoMetric.Reset()
-	c.deploymentStemcellInfoMetric.Reset()
-	c.deploymentInstancesMetric.Reset()
-
 	for _, deployment := range deployments {
-		c.reportDeploymentReleaseInfoMetrics(deployment)
-		c.reportDeploymentStemcellInfoMetrics(deployment)
-		c.reportDeploymentInstancesMetrics(deployment)
+		c.reportDeploymentReleaseInfoMetrics(deployment, ch)
+		c.reportDeploymentStemcellInfoMetrics(deployment, ch)
+		c.reportDeploymentInstancesMetrics(deployment, ch)
 	}
 
-	c.deploymentReleaseInfoMetric.Collect(ch)
-	c.deploymentReleaseJobInfoMetric.Collect(ch)
-	c.deploymentReleasePackageInfoMetric.Collect(ch)
-	c.deploymentStemcellInfoMetric.Collect(ch)
-	c.deploymentInstancesMetric.Collect(ch)
-
-	c.lastDeploymentsScrapeTimestampMetric.Set(float64(time.Now().Unix()))
-	c.lastDeploymentsScrapeTimestampMetric.Collect(ch)
-
-	c.lastDeploymentsScrapeDurationSecondsMetric.Set(time.Since(begun).Seconds())
-	c.lastDeploymentsScrapeDurationSecondsMetric.Collect(ch)
+	ch <- newGaugeMetric(c.lastDeploymentsScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastDeploymentsScrapeDurationSecondsDesc, time.Since(begun).Seconds())
 
 	return nil
 }
 
 func (c *DeploymentsCollector) Describe(ch chan<- *prometheus.Desc) {
-	c.deploymentReleaseInfoMetric.Describe(ch)
-	c.deploymentReleaseJobInfoMetric.Describe(ch)
-	c.deploymentReleasePackageInfoMetric.Describe(ch)
-	c.deploymentStemcellInfoMetric.Describe(ch)
-	c.deploymentInstancesMetric.Describe(ch)
-	c.lastDeploymentsScrapeTimestampMetric.Describe(ch)
-	c.lastDeploymentsScrapeDurationSecondsMetric.Describe(ch)
+	ch <- c.deploymentReleaseInfoDesc
+	ch <- c.deploymentReleaseJobInfoDesc
+	ch <- c.deploymentReleasePackageInfoDesc
+	ch <- c.deploymentStemcellInfoDesc
+	ch <- c.deploymentInstancesDesc
+	ch <- c.lastDeploymentsScrapeTimestampDesc
+	ch <- c.lastDeploymentsScrapeDurationSecondsDesc
 }
 
 func (c *DeploymentsCollector) reportDeploymentReleaseInfoMetrics(
 	deployment deployments.DeploymentInfo,
+	ch chan<- prometheus.Metric,
 ) {
 	for _, release := range deployment.Releases {
-		c.deploymentReleaseInfoMetric.WithLabelValues(
+		ch <- newGaugeMetric(
+			c.deploymentReleaseInfoDesc,
+			float64(1),
 			deployment.Name,
 			release.Name,
 			release.Version,
-		).Set(float64(1))
+		)
 		for _, jobName := range release.JobNames {
-			c.deploymentReleaseJobInfoMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.deploymentReleaseJobInfoDesc,
+				float64(1),
 				deployment.Name,
 				release.Name,
 				release.Version,
 				jobName,
-			).Set(float64(1))
+			)
 		}
 		for _, packageName := range release.PackageNames {
-			c.deploymentReleasePackageInfoMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.deploymentReleasePackageInfoDesc,
+				float64(1),
 				deployment.Name,
 				release.Name,
 				release.Version,
 				packageName,
-			).Set(float64(1))
+			)
 		}
 	}
 }
 
 func (c *DeploymentsCollector) reportDeploymentStemcellInfoMetrics(
 	deployment deployments.DeploymentInfo,
+	ch chan<- prometheus.Metric,
 ) {
 	for _, stemcell := range deployment.Stemcells {
-		c.deploymentStemcellInfoMetric.WithLabelValues(
+		ch <- newGaugeMetric(
+			c.deploymentStemcellInfoDesc,
+			float64(1),
 			deployment.Name,
 			stemcell.Name,
 			stemcell.Version,
 			stemcell.OSName,
-		).Set(float64(1))
+		)
 	}
 }
 
 func (c *DeploymentsCollector) reportDeploymentInstancesMetrics(
 	deployment deployments.DeploymentInfo,
+	ch chan<- prometheus.Metric,
 ) {
+	var vmTypes []string
+	instances := map[string]float64{}
 	for _, instance := range deployment.Instances {
-		c.deploymentInstancesMetric.WithLabelValues(
-			deployment.Name,
-			instance.VMType,
-		).Add(float64(1))
+		if !instance.HasVM() {
+			continue
+		}
+		if _, ok := instances[instance.VMType]; !ok {
+			vmTypes = append(vmTypes, instance.VMType)
+		}
+		instances[instance.VMType]++
+	}
+
+	for _, vmType := range vmTypes {
+		ch <- newGaugeMetric(c.deploymentInstancesDesc, instances[vmType], deployment.Name, vmType)
 	}
 }
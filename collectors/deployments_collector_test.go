@@ -1,6 +1,8 @@
 package collectors_test
 
 import (
+	"context"
+
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 
@@ -190,12 +192,12 @@ var _ = ginkgo.Describe("DeploymentsCollector", func() {
 			stemcells = []deployments.Stemcell{stemcell}
 
 			instances = []deployments.Instance{
-				{VMType: vmTypeSmall},
-				{VMType: vmTypeMedium},
-				{VMType: vmTypeMedium},
-				{VMType: vmTypeLarge},
-				{VMType: vmTypeLarge},
-				{VMType: vmTypeLarge},
+				{VMID: "fake-vm-id-1", VMType: vmTypeSmall},
+				{VMID: "fake-vm-id-2", VMType: vmTypeMedium},
+				{VMID: "fake-vm-id-3", VMType: vmTypeMedium},
+				{VMID: "fake-vm-id-4", VMType: vmTypeLarge},
+				{VMID: "fake-vm-id-5", VMType: vmTypeLarge},
+				{VMID: "fake-vm-id-6", VMType: vmTypeLarge},
 			}
 
 			deploymentInfo deployments.DeploymentInfo
@@ -215,16 +217,10 @@ var _ = ginkgo.Describe("DeploymentsCollector", func() {
 			}
 			deploymentsInfo = []deployments.DeploymentInfo{deploymentInfo}
 
-			metrics = make(chan prometheus.Metric)
-			errMetrics = make(chan error, 1)
 		})
 
 		ginkgo.JustBeforeEach(func() {
-			go func() {
-				if err := deploymentsCollector.Collect(deploymentsInfo, metrics); err != nil {
-					errMetrics <- err
-				}
-			}()
+			metrics, errMetrics = collectInBackground(context.Background(), deploymentsCollector, deploymentsInfo)
 		})
 
 		ginkgo.It("returns a deployment_release_info metric", func() {
@@ -336,6 +332,21 @@ var _ = ginkgo.Describe("DeploymentsCollector", func() {
 			})
 		})
 
+		ginkgo.Context("when an instance has no VM", func() {
+			ginkgo.BeforeEach(func() {
+				deploymentInfo.Instances = append([]deployments.Instance{{VMType: vmTypeSmall}}, instances...)
+				deploymentsInfo = []deployments.DeploymentInfo{deploymentInfo}
+			})
+
+			ginkgo.It("does not count the instance in the deployment_instances metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(deploymentInstancesMetric.WithLabelValues(
+					deploymentName,
+					vmTypeSmall,
+				))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
 		ginkgo.Context("when there are no instances", func() {
 			ginkgo.BeforeEach(func() {
 				deploymentInfo.Instances = []deployments.Instance{}
@@ -0,0 +1,115 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
+)
+
+type DirectorCollector struct {
+	boshClient                            director.Director
+	infoRefreshInterval                   time.Duration
+	directorInfoDesc                      *prometheus.Desc
+	directorFeatureEnabledDesc            *prometheus.Desc
+	lastDirectorScrapeTimestampDesc       *prometheus.Desc
+	lastDirectorScrapeDurationSecondsDesc *prometheus.Desc
+	info                                  *director.Info
+	infoRefreshedAt                       time.Time
+	mu                                    *sync.Mutex
+}
+
+func NewDirectorCollector(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+	boshClient director.Director,
+	infoRefreshInterval time.Duration,
+) *DirectorCollector {
+	metrics := NewDirectorCollectorMetrics(namespace, environment, boshName, boshUUID)
+	collector := &DirectorCollector{
+		boshClient:                            boshClient,
+		infoRefreshInterval:                   infoRefreshInterval,
+		directorInfoDesc:                      newDesc(metrics.NewDirectorInfoMetric()),
+		directorFeatureEnabledDesc:            newDesc(metrics.NewDirectorFeatureEnabledMetric()),
+		lastDirectorScrapeTimestampDesc:       newDesc(metrics.NewLastDirectorScrapeTimestampMetric()),
+		lastDirectorScrapeDurationSecondsDesc: newDesc(metrics.NewLastDirectorScrapeDurationSecondsMetric()),
+		mu:                                    &sync.Mutex{},
+	}
+	return collector
+}
+
+func (c *DirectorCollector) Collect(ctx context.Context, _ []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+	var begun = time.Now()
+
+	info, err := c.fetchInfo(ctx, begun)
+	if err == nil {
+		c.reportDirectorMetrics(info, ch)
+	}
+
+	ch <- newGaugeMetric(c.lastDirectorScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastDirectorScrapeDurationSecondsDesc, time.Since(begun).Seconds())
+
+	return err
+}
+
+func (c *DirectorCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.directorInfoDesc
+	ch <- c.directorFeatureEnabledDesc
+	ch <- c.lastDirectorScrapeTimestampDesc
+	ch <- c.lastDirectorScrapeDurationSecondsDesc
+}
+
+// fetchInfo returns the cached Director info, re-reading it once the refresh interval has
+// elapsed so that Director upgrades are picked up without restarting the exporter.
+func (c *DirectorCollector) fetchInfo(ctx context.Context, now time.Time) (director.Info, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.info != nil && now.Sub(c.infoRefreshedAt) < c.infoRefreshInterval {
+		return *c.info, nil
+	}
+
+	info, err := ctxcall.Do(ctx, c.boshClient.Info)
+	if err != nil {
+		return director.Info{}, fmt.Errorf("error while reading director info: %v", err)
+	}
+
+	if c.info != nil && c.info.Version != info.Version {
+		log.Infof("BOSH Director `%s` version changed from `%s` to `%s`", info.Name, c.info.Version, info.Version)
+	}
+
+	c.info = &info
+	c.infoRefreshedAt = now
+
+	return info, nil
+}
+
+func (c *DirectorCollector) reportDirectorMetrics(info director.Info, ch chan<- prometheus.Metric) {
+	ch <- newGaugeMetric(
+		c.directorInfoDesc,
+		float64(1),
+		info.Version,
+		info.CPI,
+		info.Auth.Type,
+		info.StemcellOS,
+		info.StemcellVersion,
+	)
+
+	for feature, enabled := range info.Features {
+		var enabledMetric float64
+		if enabled {
+			enabledMetric = 1
+		}
+
+		ch <- newGaugeMetric(c.directorFeatureEnabledDesc, enabledMetric, feature)
+	}
+}
@@ -0,0 +1,92 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type DirectorCollectorMetrics struct {
+	namespace   string
+	environment string
+	boshName    string
+	boshUUID    string
+}
+
+func NewDirectorCollectorMetrics(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+) *DirectorCollectorMetrics {
+	return &DirectorCollectorMetrics{
+		namespace:   namespace,
+		environment: environment,
+		boshName:    boshName,
+		boshUUID:    boshUUID,
+	}
+}
+
+func (m *DirectorCollectorMetrics) NewLastDirectorScrapeDurationSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_director_scrape_duration_seconds",
+			Help:      "Duration of the last scrape of Director metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *DirectorCollectorMetrics) NewLastDirectorScrapeTimestampMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_director_scrape_timestamp",
+			Help:      "Number of seconds since 1970 since last scrape of Director metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *DirectorCollectorMetrics) NewDirectorFeatureEnabledMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "director",
+			Name:      "feature_enabled",
+			Help:      "BOSH Director feature enabled (1 for enabled, 0 for disabled).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_director_feature"},
+	)
+}
+
+func (m *DirectorCollectorMetrics) NewDirectorInfoMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "director",
+			Name:      "info",
+			Help:      "BOSH Director Info with a constant '1' value.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_director_version", "bosh_director_cpi", "bosh_director_auth_type", "bosh_director_stemcell_os", "bosh_director_stemcell_version"},
+	)
+}
@@ -0,0 +1,259 @@
+package collectors_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/cloudfoundry/bosh-cli/director/directorfakes"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
+)
+
+var _ = ginkgo.Describe("DirectorCollector", func() {
+	var (
+		namespace           string
+		environment         string
+		boshName            string
+		boshUUID            string
+		boshClient          *directorfakes.FakeDirector
+		infoRefreshInterval time.Duration
+		metrics             *collectors.DirectorCollectorMetrics
+		directorCollector   *collectors.DirectorCollector
+
+		directorInfoMetric                      *prometheus.GaugeVec
+		directorFeatureEnabledMetric            *prometheus.GaugeVec
+		lastDirectorScrapeTimestampMetric       prometheus.Gauge
+		lastDirectorScrapeDurationSecondsMetric prometheus.Gauge
+
+		directorVersion         = "fake-director-version"
+		directorCPI             = "fake-director-cpi"
+		directorAuthType        = "uaa"
+		directorStemcellOS      = "fake-stemcell-os"
+		directorStemcellVersion = "fake-stemcell-version"
+	)
+
+	ginkgo.BeforeEach(func() {
+		namespace = testNamespace
+		environment = testEnvironment
+		boshName = testBoshName
+		boshUUID = testBoshUUID
+		boshClient = &directorfakes.FakeDirector{}
+		infoRefreshInterval = 5 * time.Minute
+		metrics = collectors.NewDirectorCollectorMetrics(testNamespace, testEnvironment, testBoshName, testBoshUUID)
+
+		directorInfoMetric = metrics.NewDirectorInfoMetric()
+		directorFeatureEnabledMetric = metrics.NewDirectorFeatureEnabledMetric()
+		lastDirectorScrapeTimestampMetric = metrics.NewLastDirectorScrapeTimestampMetric()
+		lastDirectorScrapeDurationSecondsMetric = metrics.NewLastDirectorScrapeDurationSecondsMetric()
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		directorCollector = collectors.NewDirectorCollector(namespace, environment, boshName, boshUUID, boshClient, infoRefreshInterval)
+	})
+
+	ginkgo.Describe("Describe", func() {
+		var (
+			descriptions chan *prometheus.Desc
+		)
+
+		ginkgo.BeforeEach(func() {
+			descriptions = make(chan *prometheus.Desc)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			go directorCollector.Describe(descriptions)
+		})
+
+		ginkgo.It("returns a director_info metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(directorInfoMetric.WithLabelValues(
+				directorVersion,
+				directorCPI,
+				directorAuthType,
+				directorStemcellOS,
+				directorStemcellVersion,
+			).Desc())))
+		})
+
+		ginkgo.It("returns a director_feature_enabled metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(directorFeatureEnabledMetric.WithLabelValues(
+				"dns",
+			).Desc())))
+		})
+
+		ginkgo.It("returns a last_director_scrape_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastDirectorScrapeTimestampMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_director_scrape_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastDirectorScrapeDurationSecondsMetric.Desc())))
+		})
+	})
+
+	ginkgo.Describe("Collect", func() {
+		var (
+			info director.Info
+
+			metrics    chan prometheus.Metric
+			errMetrics chan error
+			ctx        context.Context
+		)
+
+		ginkgo.BeforeEach(func() {
+			info = director.Info{
+				Name:            boshName,
+				UUID:            boshUUID,
+				Version:         directorVersion,
+				Auth:            director.UserAuthentication{Type: directorAuthType},
+				Features:        map[string]bool{"dns": true, "snapshots": false},
+				CPI:             directorCPI,
+				StemcellOS:      directorStemcellOS,
+				StemcellVersion: directorStemcellVersion,
+			}
+			boshClient.InfoReturns(info, nil)
+
+			directorInfoMetric.WithLabelValues(
+				directorVersion,
+				directorCPI,
+				directorAuthType,
+				directorStemcellOS,
+				directorStemcellVersion,
+			).Set(float64(1))
+			directorFeatureEnabledMetric.WithLabelValues("dns").Set(float64(1))
+			directorFeatureEnabledMetric.WithLabelValues("snapshots").Set(float64(0))
+
+			ctx = context.Background()
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			metrics, errMetrics = collectInBackground(ctx, directorCollector, []deployments.DeploymentInfo{})
+		})
+
+		ginkgo.It("returns a director_info metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorInfoMetric.WithLabelValues(
+				directorVersion,
+				directorCPI,
+				directorAuthType,
+				directorStemcellOS,
+				directorStemcellVersion,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a director_feature_enabled metric for enabled features", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorFeatureEnabledMetric.WithLabelValues(
+				"dns",
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a director_feature_enabled metric for disabled features", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorFeatureEnabledMetric.WithLabelValues(
+				"snapshots",
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when the director info has already been read", func() {
+			ginkgo.BeforeEach(func() {
+				directorInfoMetric.WithLabelValues(
+					"fake-upgraded-director-version",
+					directorCPI,
+					directorAuthType,
+					directorStemcellOS,
+					directorStemcellVersion,
+				).Set(float64(1))
+			})
+
+			ginkgo.JustBeforeEach(func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+
+				info.Version = "fake-upgraded-director-version"
+				boshClient.InfoReturns(info, nil)
+
+				metrics, errMetrics = collectInBackground(ctx, directorCollector, []deployments.DeploymentInfo{})
+			})
+
+			ginkgo.It("does not read the director info again", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorInfoMetric.WithLabelValues(
+					directorVersion,
+					directorCPI,
+					directorAuthType,
+					directorStemcellOS,
+					directorStemcellVersion,
+				))))
+				gomega.Expect(boshClient.InfoCallCount()).To(gomega.Equal(1))
+			})
+
+			ginkgo.Context("and the refresh interval has elapsed", func() {
+				ginkgo.BeforeEach(func() {
+					infoRefreshInterval = 0
+				})
+
+				ginkgo.It("returns the new director version", func() {
+					gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(directorInfoMetric.WithLabelValues(
+						"fake-upgraded-director-version",
+						directorCPI,
+						directorAuthType,
+						directorStemcellOS,
+						directorStemcellVersion,
+					))))
+					gomega.Expect(boshClient.InfoCallCount()).To(gomega.Equal(2))
+				})
+			})
+		})
+
+		ginkgo.Context("when the BOSH Director does not answer before the scrape times out", func() {
+			var cancel context.CancelFunc
+
+			ginkgo.BeforeEach(func() {
+				ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+				hang := hangingCall()
+				boshClient.InfoStub = func() (director.Info, error) {
+					hang()
+					return director.Info{}, nil
+				}
+			})
+
+			ginkgo.AfterEach(func() {
+				cancel()
+			})
+
+			ginkgo.It("returns a timeout error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive(gomega.MatchError(gomega.ContainSubstring(context.DeadlineExceeded.Error()))))
+			})
+		})
+
+		ginkgo.Context("when it fails to read the director info", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.InfoReturns(director.Info{}, errors.New("no info"))
+			})
+
+			ginkgo.It("returns only a last_director_scrape_timestamp & last_director_scrape_duration_seconds metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive())
+			})
+
+			ginkgo.It("returns an error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive())
+			})
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
+)
+
+const (
+	// eventsPageSize is the maximum number of events returned by the BOSH Director per request.
+	eventsPageSize = 200
+	// eventsMaxPages is the maximum number of pages read per scrape. Older events are not counted.
+	eventsMaxPages = 10
+)
+
+type EventsCollector struct {
+	boshClient                          director.Director
+	eventsDesc                          *prometheus.Desc
+	eventErrorsDesc                     *prometheus.Desc
+	eventsTruncatedScrapesDesc          *prometheus.Desc
+	lastEventsScrapeTimestampDesc       *prometheus.Desc
+	lastEventsScrapeDurationSecondsDesc *prometheus.Desc
+	initialized                         bool
+	lastEventID                         int
+	lastEventTimestamp                  time.Time
+	events                              map[eventLabels]float64
+	eventErrors                         map[eventLabels]float64
+	eventsTruncatedScrapes              float64
+	mu                                  *sync.Mutex
+}
+
+// eventLabels identifies the series an event is counted in.
+type eventLabels struct {
+	action         string
+	objectType     string
+	deploymentName string
+	user           string
+}
+
+func NewEventsCollector(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+	boshClient director.Director,
+) *EventsCollector {
+	metrics := NewEventsCollectorMetrics(namespace, environment, boshName, boshUUID)
+	collector := &EventsCollector{
+		boshClient:                          boshClient,
+		eventsDesc:                          newDesc(metrics.NewEventsMetric()),
+		eventErrorsDesc:                     newDesc(metrics.NewEventErrorsMetric()),
+		eventsTruncatedScrapesDesc:          newDesc(metrics.NewEventsTruncatedScrapesMetric()),
+		lastEventsScrapeTimestampDesc:       newDesc(metrics.NewLastEventsScrapeTimestampMetric()),
+		lastEventsScrapeDurationSecondsDesc: newDesc(metrics.NewLastEventsScrapeDurationSecondsMetric()),
+		events:                              map[eventLabels]float64{},
+		eventErrors:                         map[eventLabels]float64{},
+		mu:                                  &sync.Mutex{},
+	}
+	return collector
+}
+
+func (c *EventsCollector) Collect(ctx context.Context, _ []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+	var begun = time.Now()
+
+	eventsMetrics, err := c.countNewEvents(ctx)
+	for _, metric := range eventsMetrics {
+		ch <- metric
+	}
+
+	ch <- newGaugeMetric(c.lastEventsScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastEventsScrapeDurationSecondsDesc, time.Since(begun).Seconds())
+
+	return err
+}
+
+func (c *EventsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.eventsDesc
+	ch <- c.eventErrorsDesc
+	ch <- c.eventsTruncatedScrapesDesc
+	ch <- c.lastEventsScrapeTimestampDesc
+	ch <- c.lastEventsScrapeDurationSecondsDesc
+}
+
+// countNewEvents adds the events recorded since the last scrape to the counters and returns a
+// snapshot of all of them, so concurrent scrapes never observe a partially updated counter set.
+func (c *EventsCollector) countNewEvents(ctx context.Context) ([]prometheus.Metric, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	events, err := c.fetchNewEvents(ctx)
+	if err == nil {
+		c.reportEventsMetrics(events)
+	}
+
+	metrics := make([]prometheus.Metric, 0, len(c.events)+len(c.eventErrors)+1)
+	for labels, count := range c.events {
+		metrics = append(metrics, labels.newCounterMetric(c.eventsDesc, count))
+	}
+	for labels, count := range c.eventErrors {
+		metrics = append(metrics, labels.newCounterMetric(c.eventErrorsDesc, count))
+	}
+	metrics = append(metrics, prometheus.MustNewConstMetric(
+		c.eventsTruncatedScrapesDesc,
+		prometheus.CounterValue,
+		c.eventsTruncatedScrapes,
+	))
+
+	return metrics, err
+}
+
+// fetchNewEvents returns the events recorded after the cursor, newest first, and moves the cursor
+// to the newest one. The first call only positions the cursor, so counters start from zero
+// instead of replaying the whole BOSH Director audit trail. When more than eventsMaxPages pages
+// were recorded since the last scrape, the older events are not counted and the truncation is
+// logged and counted.
+func (c *EventsCollector) fetchNewEvents(ctx context.Context) ([]director.Event, error) {
+	var newEvents []director.Event
+	var truncated = true
+
+	filter := director.EventsFilter{}
+	if !c.lastEventTimestamp.IsZero() {
+		filter.After = c.lastEventTimestamp.UTC().Format(time.RFC3339)
+	}
+
+	for page := 0; page < eventsMaxPages; page++ {
+		events, err := ctxcall.Do(ctx, func() ([]director.Event, error) {
+			return c.boshClient.Events(filter)
+		})
+		if err != nil {
+			return newEvents, fmt.Errorf("error while reading events: %v", err)
+		}
+
+		reachedCursor := false
+		for _, event := range events {
+			eventID, err := strconv.Atoi(event.ID())
+			if err != nil {
+				continue
+			}
+			if eventID <= c.lastEventID {
+				reachedCursor = true
+				break
+			}
+			newEvents = append(newEvents, event)
+		}
+
+		if !c.initialized || reachedCursor || len(events) < eventsPageSize {
+			truncated = false
+			break
+		}
+		filter.BeforeID = events[len(events)-1].ID()
+	}
+
+	if truncated {
+		c.eventsTruncatedScrapes++
+		log.Warnf(
+			"More than %d events were recorded since event `%d`, events older than `%s` are not counted",
+			eventsPageSize*eventsMaxPages,
+			c.lastEventID,
+			filter.BeforeID,
+		)
+	}
+
+	if len(newEvents) > 0 {
+		c.lastEventID, _ = strconv.Atoi(newEvents[0].ID())
+		c.lastEventTimestamp = newEvents[0].Timestamp()
+	}
+
+	if !c.initialized {
+		c.initialized = true
+		return nil, nil
+	}
+
+	return newEvents, nil
+}
+
+func (c *EventsCollector) reportEventsMetrics(events []director.Event) {
+	for _, event := range events {
+		labels := eventLabels{
+			action:         event.Action(),
+			objectType:     event.ObjectType(),
+			deploymentName: event.DeploymentName(),
+			user:           event.User(),
+		}
+
+		c.events[labels]++
+
+		if event.Error() != "" {
+			c.eventErrors[labels]++
+		}
+	}
+}
+
+func (l eventLabels) newCounterMetric(desc *prometheus.Desc, count float64) prometheus.Metric {
+	return prometheus.MustNewConstMetric(
+		desc,
+		prometheus.CounterValue,
+		count,
+		l.action,
+		l.objectType,
+		l.deploymentName,
+		l.user,
+	)
+}
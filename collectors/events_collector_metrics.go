@@ -0,0 +1,108 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type EventsCollectorMetrics struct {
+	namespace   string
+	environment string
+	boshName    string
+	boshUUID    string
+}
+
+func NewEventsCollectorMetrics(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+) *EventsCollectorMetrics {
+	return &EventsCollectorMetrics{
+		namespace:   namespace,
+		environment: environment,
+		boshName:    boshName,
+		boshUUID:    boshUUID,
+	}
+}
+
+func (m *EventsCollectorMetrics) NewLastEventsScrapeDurationSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_events_scrape_duration_seconds",
+			Help:      "Duration of the last scrape of Events metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *EventsCollectorMetrics) NewLastEventsScrapeTimestampMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_events_scrape_timestamp",
+			Help:      "Number of seconds since 1970 since last scrape of Events metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *EventsCollectorMetrics) NewEventErrorsMetric() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "event_errors_total",
+			Help:      "Total number of BOSH Director audit events that carry an error since the exporter started.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_event_action", "bosh_event_object_type", "bosh_deployment", "bosh_event_user"},
+	)
+}
+
+func (m *EventsCollectorMetrics) NewEventsTruncatedScrapesMetric() prometheus.Counter {
+	return prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "events_truncated_scrapes_total",
+			Help:      "Total number of Events scrapes that did not count the oldest new events, because too many were recorded since the previous scrape.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *EventsCollectorMetrics) NewEventsMetric() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "events_total",
+			Help:      "Total number of BOSH Director audit events since the exporter started.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_event_action", "bosh_event_object_type", "bosh_deployment", "bosh_event_user"},
+	)
+}
@@ -0,0 +1,257 @@
+package collectors_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/cloudfoundry/bosh-cli/director/directorfakes"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
+)
+
+func newFakeEvent(id string, action string, eventError string) *directorfakes.FakeEvent {
+	event := &directorfakes.FakeEvent{}
+	event.IDReturns(id)
+	event.TimestampReturns(time.Unix(1700000000, 0))
+	event.ActionReturns(action)
+	event.ObjectTypeReturns("instance")
+	event.DeploymentNameReturns("fake-deployment-name")
+	event.UserReturns("fake-user")
+	event.ErrorReturns(eventError)
+	return event
+}
+
+var _ = ginkgo.Describe("EventsCollector", func() {
+	var (
+		namespace       string
+		environment     string
+		boshName        string
+		boshUUID        string
+		boshClient      *directorfakes.FakeDirector
+		metrics         *collectors.EventsCollectorMetrics
+		eventsCollector *collectors.EventsCollector
+
+		eventsMetric                          *prometheus.CounterVec
+		eventErrorsMetric                     *prometheus.CounterVec
+		eventsTruncatedScrapesMetric          prometheus.Counter
+		lastEventsScrapeTimestampMetric       prometheus.Gauge
+		lastEventsScrapeDurationSecondsMetric prometheus.Gauge
+
+		deploymentName  = "fake-deployment-name"
+		eventObjectType = "instance"
+		eventUser       = "fake-user"
+	)
+
+	ginkgo.BeforeEach(func() {
+		namespace = testNamespace
+		environment = testEnvironment
+		boshName = testBoshName
+		boshUUID = testBoshUUID
+		boshClient = &directorfakes.FakeDirector{}
+		metrics = collectors.NewEventsCollectorMetrics(testNamespace, testEnvironment, testBoshName, testBoshUUID)
+
+		eventsMetric = metrics.NewEventsMetric()
+		eventErrorsMetric = metrics.NewEventErrorsMetric()
+		eventsTruncatedScrapesMetric = metrics.NewEventsTruncatedScrapesMetric()
+		lastEventsScrapeTimestampMetric = metrics.NewLastEventsScrapeTimestampMetric()
+		lastEventsScrapeDurationSecondsMetric = metrics.NewLastEventsScrapeDurationSecondsMetric()
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		eventsCollector = collectors.NewEventsCollector(namespace, environment, boshName, boshUUID, boshClient)
+	})
+
+	ginkgo.Describe("Describe", func() {
+		var (
+			descriptions chan *prometheus.Desc
+		)
+
+		ginkgo.BeforeEach(func() {
+			descriptions = make(chan *prometheus.Desc)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			go eventsCollector.Describe(descriptions)
+		})
+
+		ginkgo.It("returns an events_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(eventsMetric.WithLabelValues(
+				"delete",
+				eventObjectType,
+				deploymentName,
+				eventUser,
+			).Desc())))
+		})
+
+		ginkgo.It("returns an event_errors_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(eventErrorsMetric.WithLabelValues(
+				"delete",
+				eventObjectType,
+				deploymentName,
+				eventUser,
+			).Desc())))
+		})
+
+		ginkgo.It("returns an events_truncated_scrapes_total metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(eventsTruncatedScrapesMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_events_scrape_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastEventsScrapeTimestampMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_events_scrape_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastEventsScrapeDurationSecondsMetric.Desc())))
+		})
+	})
+
+	ginkgo.Describe("Collect", func() {
+		var (
+			metrics    chan prometheus.Metric
+			errMetrics chan error
+			ctx        context.Context
+		)
+
+		ginkgo.BeforeEach(func() {
+			boshClient.EventsReturnsOnCall(0, []director.Event{
+				newFakeEvent("10", "update", ""),
+			}, nil)
+			boshClient.EventsReturnsOnCall(1, []director.Event{
+				newFakeEvent("13", "recreate", ""),
+				newFakeEvent("12", "delete", "fake-error"),
+				newFakeEvent("11", "delete", ""),
+				newFakeEvent("10", "update", ""),
+			}, nil)
+
+			eventsMetric.WithLabelValues("recreate", eventObjectType, deploymentName, eventUser).Inc()
+			eventsMetric.WithLabelValues("delete", eventObjectType, deploymentName, eventUser).Add(2)
+			eventErrorsMetric.WithLabelValues("delete", eventObjectType, deploymentName, eventUser).Inc()
+
+			ctx = context.Background()
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			firstScrape := make(chan prometheus.Metric, 10)
+			gomega.Expect(eventsCollector.Collect(ctx, []deployments.DeploymentInfo{}, firstScrape)).To(gomega.Succeed())
+
+			metrics, errMetrics = collectInBackground(ctx, eventsCollector, []deployments.DeploymentInfo{})
+		})
+
+		ginkgo.It("returns an events_total metric for the events after the cursor", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(eventsMetric.WithLabelValues(
+				"delete",
+				eventObjectType,
+				deploymentName,
+				eventUser,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns an event_errors_total metric for the events with an error", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(eventErrorsMetric.WithLabelValues(
+				"delete",
+				eventObjectType,
+				deploymentName,
+				eventUser,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("does not count the events before the cursor", func() {
+			gomega.Consistently(metrics).ShouldNot(gomega.Receive(matchers.PrometheusMetric(eventsMetric.WithLabelValues(
+				"update",
+				eventObjectType,
+				deploymentName,
+				eventUser,
+			))))
+		})
+
+		ginkgo.It("only reads events after the last event seen", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive())
+			gomega.Expect(boshClient.EventsArgsForCall(1).After).To(gomega.Equal(time.Unix(1700000000, 0).UTC().Format(time.RFC3339)))
+		})
+
+		ginkgo.It("returns an events_truncated_scrapes_total metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(eventsTruncatedScrapesMetric)))
+		})
+
+		ginkgo.Context("when more events than the maximum number of pages were recorded", func() {
+			ginkgo.BeforeEach(func() {
+				for page := 0; page < 11; page++ {
+					events := make([]director.Event, 0, 200)
+					for i := 0; i < 200; i++ {
+						events = append(events, newFakeEvent(strconv.Itoa(2210-page*200-i), "recreate", ""))
+					}
+					boshClient.EventsReturnsOnCall(page+1, events, nil)
+				}
+
+				eventsMetric.WithLabelValues("recreate", eventObjectType, deploymentName, eventUser).Add(2000 - 1)
+				eventsTruncatedScrapesMetric.Inc()
+			})
+
+			ginkgo.It("counts the events of the pages read", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(eventsMetric.WithLabelValues(
+					"recreate",
+					eventObjectType,
+					deploymentName,
+					eventUser,
+				))))
+			})
+
+			ginkgo.It("returns an events_truncated_scrapes_total metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(eventsTruncatedScrapesMetric)))
+				gomega.Expect(boshClient.EventsCallCount()).To(gomega.Equal(11))
+			})
+		})
+
+		ginkgo.Context("when the BOSH Director does not answer before the scrape times out", func() {
+			var cancel context.CancelFunc
+
+			ginkgo.BeforeEach(func() {
+				ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+				hang := hangingCall()
+				fakeDirector := boshClient
+				boshClient.EventsStub = func(director.EventsFilter) ([]director.Event, error) {
+					if fakeDirector.EventsCallCount() > 1 {
+						hang()
+					}
+					return []director.Event{newFakeEvent("10", "update", "")}, nil
+				}
+			})
+
+			ginkgo.AfterEach(func() {
+				cancel()
+			})
+
+			ginkgo.It("returns a timeout error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive(gomega.MatchError(gomega.ContainSubstring(context.DeadlineExceeded.Error()))))
+			})
+		})
+
+		ginkgo.Context("when it fails to get the events", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.EventsReturnsOnCall(1, nil, errors.New("no events"))
+			})
+
+			ginkgo.It("returns an error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive())
+			})
+		})
+	})
+})
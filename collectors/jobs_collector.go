@@ -1,6 +1,7 @@
 package collectors
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,34 +12,54 @@ import (
 	"github.com/cloudfoundry/bosh_exporter/filters"
 )
 
+var (
+	// jobInstanceStates are the instance states known to the BOSH Director.
+	jobInstanceStates = []string{"started", "stopped", "detached"}
+
+	// jobStates are the job states reported by the BOSH Agent (plus the Director's own `unresponsive agent`).
+	jobStates = []string{"running", "starting", "failing", "stopped", "unknown", "unresponsive agent"}
+
+	// jobProcessStates are the process states reported by monit through the BOSH Agent.
+	jobProcessStates = []string{"running", "starting", "failing", "stopped", "unknown", "unmonitored"}
+)
+
 type JobsCollector struct {
-	azsFilter                           *filters.AZsFilter
-	cidrsFilter                         *filters.CidrFilter
-	jobHealthyMetric                    *prometheus.GaugeVec
-	jobLoadAvg01Metric                  *prometheus.GaugeVec
-	jobLoadAvg05Metric                  *prometheus.GaugeVec
-	jobLoadAvg15Metric                  *prometheus.GaugeVec
-	jobCPUSysMetric                     *prometheus.GaugeVec
-	jobCPUUserMetric                    *prometheus.GaugeVec
-	jobCPUWaitMetric                    *prometheus.GaugeVec
-	jobMemKBMetric                      *prometheus.GaugeVec
-	jobMemPercentMetric                 *prometheus.GaugeVec
-	jobSwapKBMetric                     *prometheus.GaugeVec
-	jobSwapPercentMetric                *prometheus.GaugeVec
-	jobSystemDiskInodePercentMetric     *prometheus.GaugeVec
-	jobSystemDiskPercentMetric          *prometheus.GaugeVec
-	jobEphemeralDiskInodePercentMetric  *prometheus.GaugeVec
-	jobEphemeralDiskPercentMetric       *prometheus.GaugeVec
-	jobPersistentDiskInodePercentMetric *prometheus.GaugeVec
-	jobPersistentDiskPercentMetric      *prometheus.GaugeVec
-	jobProcessInfoMetric                *prometheus.GaugeVec
-	jobProcessHealthyMetric             *prometheus.GaugeVec
-	jobProcessUptimeMetric              *prometheus.GaugeVec
-	jobProcessCPUTotalMetric            *prometheus.GaugeVec
-	jobProcessMemKBMetric               *prometheus.GaugeVec
-	jobProcessMemPercentMetric          *prometheus.GaugeVec
-	lastJobsScrapeTimestampMetric       prometheus.Gauge
-	lastJobsScrapeDurationSecondsMetric prometheus.Gauge
+	azsFilter                         *filters.AZsFilter
+	cidrsFilter                       *filters.CidrFilter
+	jobInfoDesc                       *prometheus.Desc
+	jobInstanceStateDesc              *prometheus.Desc
+	jobIgnoreDesc                     *prometheus.Desc
+	jobStateDesc                      *prometheus.Desc
+	jobActiveDesc                     *prometheus.Desc
+	jobHealthyDesc                    *prometheus.Desc
+	jobResurrectionPausedDesc         *prometheus.Desc
+	jobUptimeDesc                     *prometheus.Desc
+	jobVMCreatedTimestampDesc         *prometheus.Desc
+	jobLoadAvg01Desc                  *prometheus.Desc
+	jobLoadAvg05Desc                  *prometheus.Desc
+	jobLoadAvg15Desc                  *prometheus.Desc
+	jobCPUSysDesc                     *prometheus.Desc
+	jobCPUUserDesc                    *prometheus.Desc
+	jobCPUWaitDesc                    *prometheus.Desc
+	jobMemKBDesc                      *prometheus.Desc
+	jobMemPercentDesc                 *prometheus.Desc
+	jobSwapKBDesc                     *prometheus.Desc
+	jobSwapPercentDesc                *prometheus.Desc
+	jobSystemDiskInodePercentDesc     *prometheus.Desc
+	jobSystemDiskPercentDesc          *prometheus.Desc
+	jobEphemeralDiskInodePercentDesc  *prometheus.Desc
+	jobEphemeralDiskPercentDesc       *prometheus.Desc
+	jobPersistentDiskInodePercentDesc *prometheus.Desc
+	jobPersistentDiskPercentDesc      *prometheus.Desc
+	jobProcessInfoDesc                *prometheus.Desc
+	jobProcessHealthyDesc             *prometheus.Desc
+	jobProcessStateDesc               *prometheus.Desc
+	jobProcessUptimeDesc              *prometheus.Desc
+	jobProcessCPUTotalDesc            *prometheus.Desc
+	jobProcessMemKBDesc               *prometheus.Desc
+	jobProcessMemPercentDesc          *prometheus.Desc
+	lastJobsScrapeTimestampDesc       *prometheus.Desc
+	lastJobsScrapeDurationSecondsDesc *prometheus.Desc
 }
 
 func NewJobsCollector(
@@ -51,131 +72,98 @@ func NewJobsCollector(
 ) *JobsCollector {
 	metrics := NewJobsCollectorMetrics(namespace, environment, boshName, boshUUID)
 	collector := &JobsCollector{
-		azsFilter:                           azsFilter,
-		cidrsFilter:                         cidrsFilter,
-		jobHealthyMetric:                    metrics.NewJobHealthyMetric(),
-		jobLoadAvg01Metric:                  metrics.NewJobLoadAvg01Metric(),
-		jobLoadAvg05Metric:                  metrics.NewJobLoadAvg05Metric(),
-		jobLoadAvg15Metric:                  metrics.NewJobLoadAvg15Metric(),
-		jobCPUSysMetric:                     metrics.NewJobCPUSysMetric(),
-		jobCPUUserMetric:                    metrics.NewJobCPUUserMetric(),
-		jobCPUWaitMetric:                    metrics.NewJobCPUWaitMetric(),
-		jobMemKBMetric:                      metrics.NewJobMemKBMetric(),
-		jobMemPercentMetric:                 metrics.NewJobMemPercentMetric(),
-		jobSwapKBMetric:                     metrics.NewJobSwapKBMetric(),
-		jobSwapPercentMetric:                metrics.NewJobSwapPercentMetric(),
-		jobSystemDiskInodePercentMetric:     metrics.NewJobSystemDiskInodePercentMetric(),
-		jobSystemDiskPercentMetric:          metrics.NewJobSystemDiskPercentMetric(),
-		jobEphemeralDiskInodePercentMetric:  metrics.NewJobEphemeralDiskInodePercentMetric(),
-		jobEphemeralDiskPercentMetric:       metrics.NewJobEphemeralDiskPercentMetric(),
-		jobPersistentDiskInodePercentMetric: metrics.NewJobPersistentDiskInodePercentMetric(),
-		jobPersistentDiskPercentMetric:      metrics.NewJobPersistentDiskPercentMetric(),
-		jobProcessInfoMetric:                metrics.NewJobProcessInfoMetric(),
-		jobProcessHealthyMetric:             metrics.NewJobProcessHealthyMetric(),
-		jobProcessUptimeMetric:              metrics.NewJobProcessUptimeMetric(),
-		jobProcessCPUTotalMetric:            metrics.NewJobProcessCPUTotalMetric(),
-		jobProcessMemKBMetric:               metrics.NewJobProcessMemKBMetric(),
-		jobProcessMemPercentMetric:          metrics.NewJobProcessMemPercentMetric(),
-		lastJobsScrapeTimestampMetric:       metrics.NewLastJobsScrapeTimestampMetric(),
-		lastJobsScrapeDurationSecondsMetric: metrics.NewLastJobsScrapeDurationSecondsMetric(),
+		azsFilter:                         azsFilter,
+		cidrsFilter:                       cidrsFilter,
+		jobInfoDesc:                       newDesc(metrics.NewJobInfoMetric()),
+		jobInstanceStateDesc:              newDesc(metrics.NewJobInstanceStateMetric()),
+		jobIgnoreDesc:                     newDesc(metrics.NewJobIgnoreMetric()),
+		jobStateDesc:                      newDesc(metrics.NewJobStateMetric()),
+		jobActiveDesc:                     newDesc(metrics.NewJobActiveMetric()),
+		jobHealthyDesc:                    newDesc(metrics.NewJobHealthyMetric()),
+		jobResurrectionPausedDesc:         newDesc(metrics.NewJobResurrectionPausedMetric()),
+		jobUptimeDesc:                     newDesc(metrics.NewJobUptimeMetric()),
+		jobVMCreatedTimestampDesc:         newDesc(metrics.NewJobVMCreatedTimestampMetric()),
+		jobLoadAvg01Desc:                  newDesc(metrics.NewJobLoadAvg01Metric()),
+		jobLoadAvg05Desc:                  newDesc(metrics.NewJobLoadAvg05Metric()),
+		jobLoadAvg15Desc:                  newDesc(metrics.NewJobLoadAvg15Metric()),
+		jobCPUSysDesc:                     newDesc(metrics.NewJobCPUSysMetric()),
+		jobCPUUserDesc:                    newDesc(metrics.NewJobCPUUserMetric()),
+		jobCPUWaitDesc:                    newDesc(metrics.NewJobCPUWaitMetric()),
+		jobMemKBDesc:                      newDesc(metrics.NewJobMemKBMetric()),
+		jobMemPercentDesc:                 newDesc(metrics.NewJobMemPercentMetric()),
+		jobSwapKBDesc:                     newDesc(metrics.NewJobSwapKBMetric()),
+		jobSwapPercentDesc:                newDesc(metrics.NewJobSwapPercentMetric()),
+		jobSystemDiskInodePercentDesc:     newDesc(metrics.NewJobSystemDiskInodePercentMetric()),
+		jobSystemDiskPercentDesc:          newDesc(metrics.NewJobSystemDiskPercentMetric()),
+		jobEphemeralDiskInodePercentDesc:  newDesc(metrics.NewJobEphemeralDiskInodePercentMetric()),
+		jobEphemeralDiskPercentDesc:       newDesc(metrics.NewJobEphemeralDiskPercentMetric()),
+		jobPersistentDiskInodePercentDesc: newDesc(metrics.NewJobPersistentDiskInodePercentMetric()),
+		jobPersistentDiskPercentDesc:      newDesc(metrics.NewJobPersistentDiskPercentMetric()),
+		jobProcessInfoDesc:                newDesc(metrics.NewJobProcessInfoMetric()),
+		jobProcessHealthyDesc:             newDesc(metrics.NewJobProcessHealthyMetric()),
+		jobProcessStateDesc:               newDesc(metrics.NewJobProcessStateMetric()),
+		jobProcessUptimeDesc:              newDesc(metrics.NewJobProcessUptimeMetric()),
+		jobProcessCPUTotalDesc:            newDesc(metrics.NewJobProcessCPUTotalMetric()),
+		jobProcessMemKBDesc:               newDesc(metrics.NewJobProcessMemKBMetric()),
+		jobProcessMemPercentDesc:          newDesc(metrics.NewJobProcessMemPercentMetric()),
+		lastJobsScrapeTimestampDesc:       newDesc(metrics.NewLastJobsScrapeTimestampMetric()),
+		lastJobsScrapeDurationSecondsDesc: newDesc(metrics.NewLastJobsScrapeDurationSecondsMetric()),
 	}
 	return collector
 }
 
-func (c *JobsCollector) Collect(deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+func (c *JobsCollector) Collect(_ context.Context, deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
 	var err error
 	var begun = time.Now()
 
-	c.jobHealthyMetric.Reset()
-	c.jobLoadAvg01Metric.Reset()
-	c.jobLoadAvg05Metric.Reset()
-	c.jobLoadAvg15Metric.Reset()
-	c.jobCPUSysMetric.Reset()
-	c.jobCPUUserMetric.Reset()
-	c.jobCPUWaitMetric.Reset()
-	c.jobMemKBMetric.Reset()
-	c.jobMemPercentMetric.Reset()
-	c.jobSwapKBMetric.Reset()
-	c.jobSwapPercentMetric.Reset()
-	c.jobSystemDiskInodePercentMetric.Reset()
-	c.jobSystemDiskPercentMetric.Reset()
-	c.jobEphemeralDiskInodePercentMetric.Reset()
-	c.jobEphemeralDiskPercentMetric.Reset()
-	c.jobPersistentDiskInodePercentMetric.Reset()
-	c.jobPersistentDiskPercentMetric.Reset()
-	c.jobProcessInfoMetric.Reset()
-	c.jobProcessHealthyMetric.Reset()
-	c.jobProcessUptimeMetric.Reset()
-	c.jobProcessCPUTotalMetric.Reset()
-	c.jobProcessMemKBMetric.Reset()
-	c.jobProcessMemPercentMetric.Reset()
-
 	for _, deployment := range deployments {
-		err = c.reportJobMetrics(deployment)
+		err = c.reportJobMetrics(deployment, ch)
 	}
 
-	c.jobHealthyMetric.Collect(ch)
-	c.jobLoadAvg01Metric.Collect(ch)
-	c.jobLoadAvg05Metric.Collect(ch)
-	c.jobLoadAvg15Metric.Collect(ch)
-	c.jobCPUSysMetric.Collect(ch)
-	c.jobCPUUserMetric.Collect(ch)
-	c.jobCPUWaitMetric.Collect(ch)
-	c.jobMemKBMetric.Collect(ch)
-	c.jobMemPercentMetric.Collect(ch)
-	c.jobSwapKBMetric.Collect(ch)
-	c.jobSwapPercentMetric.Collect(ch)
-	c.jobSystemDiskInodePercentMetric.Collect(ch)
-	c.jobSystemDiskPercentMetric.Collect(ch)
-	c.jobEphemeralDiskInodePercentMetric.Collect(ch)
-	c.jobEphemeralDiskPercentMetric.Collect(ch)
-	c.jobPersistentDiskInodePercentMetric.Collect(ch)
-	c.jobPersistentDiskPercentMetric.Collect(ch)
-	c.jobProcessInfoMetric.Collect(ch)
-	c.jobProcessHealthyMetric.Collect(ch)
-	c.jobProcessUptimeMetric.Collect(ch)
-	c.jobProcessCPUTotalMetric.Collect(ch)
-	c.jobProcessMemKBMetric.Collect(ch)
-	c.jobProcessMemPercentMetric.Collect(ch)
-
-	c.lastJobsScrapeTimestampMetric.Set(float64(time.Now().Unix()))
-	c.lastJobsScrapeTimestampMetric.Collect(ch)
-
-	c.lastJobsScrapeDurationSecondsMetric.Set(time.Since(begun).Seconds())
-	c.lastJobsScrapeDurationSecondsMetric.Collect(ch)
+	ch <- newGaugeMetric(c.lastJobsScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastJobsScrapeDurationSecondsDesc, time.Since(begun).Seconds())
 
 	return err
 }
 
 func (c *JobsCollector) Describe(ch chan<- *prometheus.Desc) {
-	c.jobHealthyMetric.Describe(ch)
-	c.jobLoadAvg01Metric.Describe(ch)
-	c.jobLoadAvg05Metric.Describe(ch)
-	c.jobLoadAvg15Metric.Describe(ch)
-	c.jobCPUSysMetric.Describe(ch)
-	c.jobCPUUserMetric.Describe(ch)
-	c.jobCPUWaitMetric.Describe(ch)
-	c.jobMemKBMetric.Describe(ch)
-	c.jobMemPercentMetric.Describe(ch)
-	c.jobSwapKBMetric.Describe(ch)
-	c.jobSwapPercentMetric.Describe(ch)
-	c.jobSystemDiskInodePercentMetric.Describe(ch)
-	c.jobSystemDiskPercentMetric.Describe(ch)
-	c.jobEphemeralDiskInodePercentMetric.Describe(ch)
-	c.jobEphemeralDiskPercentMetric.Describe(ch)
-	c.jobPersistentDiskInodePercentMetric.Describe(ch)
-	c.jobPersistentDiskPercentMetric.Describe(ch)
-	c.jobProcessInfoMetric.Describe(ch)
-	c.jobProcessHealthyMetric.Describe(ch)
-	c.jobProcessUptimeMetric.Describe(ch)
-	c.jobProcessCPUTotalMetric.Describe(ch)
-	c.jobProcessMemKBMetric.Describe(ch)
-	c.jobProcessMemPercentMetric.Describe(ch)
-	c.lastJobsScrapeTimestampMetric.Describe(ch)
-	c.lastJobsScrapeDurationSecondsMetric.Describe(ch)
+	ch <- c.jobInfoDesc
+	ch <- c.jobInstanceStateDesc
+	ch <- c.jobIgnoreDesc
+	ch <- c.jobStateDesc
+	ch <- c.jobActiveDesc
+	ch <- c.jobHealthyDesc
+	ch <- c.jobResurrectionPausedDesc
+	ch <- c.jobUptimeDesc
+	ch <- c.jobVMCreatedTimestampDesc
+	ch <- c.jobLoadAvg01Desc
+	ch <- c.jobLoadAvg05Desc
+	ch <- c.jobLoadAvg15Desc
+	ch <- c.jobCPUSysDesc
+	ch <- c.jobCPUUserDesc
+	ch <- c.jobCPUWaitDesc
+	ch <- c.jobMemKBDesc
+	ch <- c.jobMemPercentDesc
+	ch <- c.jobSwapKBDesc
+	ch <- c.jobSwapPercentDesc
+	ch <- c.jobSystemDiskInodePercentDesc
+	ch <- c.jobSystemDiskPercentDesc
+	ch <- c.jobEphemeralDiskInodePercentDesc
+	ch <- c.jobEphemeralDiskPercentDesc
+	ch <- c.jobPersistentDiskInodePercentDesc
+	ch <- c.jobPersistentDiskPercentDesc
+	ch <- c.jobProcessInfoDesc
+	ch <- c.jobProcessHealthyDesc
+	ch <- c.jobProcessStateDesc
+	ch <- c.jobProcessUptimeDesc
+	ch <- c.jobProcessCPUTotalDesc
+	ch <- c.jobProcessMemKBDesc
+	ch <- c.jobProcessMemPercentDesc
+	ch <- c.lastJobsScrapeTimestampDesc
+	ch <- c.lastJobsScrapeDurationSecondsDesc
 }
 
-func (c *JobsCollector) reportJobMetrics(deployment deployments.DeploymentInfo) error {
+func (c *JobsCollector) reportJobMetrics(deployment deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
 	var endErr error
 
 	for _, instance := range deployment.Instances {
@@ -190,39 +178,52 @@ func (c *JobsCollector) reportJobMetrics(deployment deployments.DeploymentInfo)
 		jobAZ := instance.AZ
 		jobIP, _ := c.cidrsFilter.Select(instance.IPs)
 
-		c.jobHealthyMetrics(instance.Healthy, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		c.jobInfoMetrics(instance, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobInstanceStateMetrics(instance.State, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobIgnoreMetrics(instance.Ignore, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 
-		err := c.jobLoadAvgMetrics(instance.Vitals.Load, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		if !instance.HasVM() {
+			continue
+		}
+
+		c.jobStateMetrics(instance.ProcessState, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobActiveMetrics(instance.Active, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobHealthyMetrics(instance.Healthy, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobResurrectionPausedMetrics(instance.ResurrectionPaused, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobUptimeMetrics(instance.Vitals.Uptime, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+		c.jobVMCreatedTimestampMetrics(instance.VMCreatedAt, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
+
+		err := c.jobLoadAvgMetrics(instance.Vitals.Load, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
 
-		err = c.jobCPUMetrics(instance.Vitals.CPU, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		err = c.jobCPUMetrics(instance.Vitals.CPU, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
 
-		err = c.jobMemMetrics(instance.Vitals.Mem, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		err = c.jobMemMetrics(instance.Vitals.Mem, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
 
-		err = c.jobSwapMetrics(instance.Vitals.Swap, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		err = c.jobSwapMetrics(instance.Vitals.Swap, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
 
-		err = c.jobSystemDiskMetrics(instance.Vitals.SystemDisk, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		err = c.jobSystemDiskMetrics(instance.Vitals.SystemDisk, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
 
-		err = c.jobEphemeralDiskMetrics(instance.Vitals.EphemeralDisk, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		err = c.jobEphemeralDiskMetrics(instance.Vitals.EphemeralDisk, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
 
-		err = c.jobPersistentDiskMetrics(instance.Vitals.PersistentDisk, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+		err = c.jobPersistentDiskMetrics(instance.Vitals.PersistentDisk, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, ch)
 		if err != nil {
 			endErr = err
 		}
@@ -230,17 +231,127 @@ func (c *JobsCollector) reportJobMetrics(deployment deployments.DeploymentInfo)
 		for _, process := range instance.Processes {
 			jobProcessName := process.Name
 			release, _ := deployment.FindReleaseByJobName(jobProcessName)
-			c.jobProcessInfoMetrics(deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, release)
-			c.jobProcessHealthyMetrics(process.Healthy, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName)
-			c.jobProcessUptimeMetrics(process.Uptime, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName)
-			c.jobProcessCPUMetrics(process.CPU, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName)
-			c.jobProcessMemMetrics(process.Mem, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName)
+			c.jobProcessInfoMetrics(deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, release, ch)
+			c.jobProcessHealthyMetrics(process.Healthy, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, ch)
+			c.jobProcessStateMetrics(process.State, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, ch)
+			c.jobProcessUptimeMetrics(process.Uptime, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, ch)
+			c.jobProcessCPUMetrics(process.CPU, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, ch)
+			c.jobProcessMemMetrics(process.Mem, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName, ch)
 		}
 	}
 
 	return endErr
 }
 
+func (c *JobsCollector) jobInfoMetrics(
+	instance deployments.Instance,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	ch <- newGaugeMetric(
+		c.jobInfoDesc,
+		1,
+		deploymentName,
+		jobName,
+		jobID,
+		jobIndex,
+		jobAZ,
+		jobIP,
+		instance.AgentID,
+		instance.VMID,
+		instance.VMType,
+		instance.ResourcePool,
+		strconv.FormatBool(instance.Bootstrap),
+	)
+}
+
+func (c *JobsCollector) jobInstanceStateMetrics(
+	state string,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	stateSetMetrics(ch, c.jobInstanceStateDesc, jobInstanceStates, state, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+}
+
+func (c *JobsCollector) jobIgnoreMetrics(
+	ignore bool,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	var ignoreMetric float64
+	if ignore {
+		ignoreMetric = 1
+	}
+
+	ch <- newGaugeMetric(
+		c.jobIgnoreDesc,
+		ignoreMetric,
+		deploymentName,
+		jobName,
+		jobID,
+		jobIndex,
+		jobAZ,
+		jobIP,
+	)
+}
+
+func (c *JobsCollector) jobStateMetrics(
+	state string,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	stateSetMetrics(ch, c.jobStateDesc, jobStates, state, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP)
+}
+
+func (c *JobsCollector) jobActiveMetrics(
+	active *bool,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	if active != nil {
+		var activeMetric float64
+		if *active {
+			activeMetric = 1
+		}
+
+		ch <- newGaugeMetric(
+			c.jobActiveDesc,
+			activeMetric,
+			deploymentName,
+			jobName,
+			jobID,
+			jobIndex,
+			jobAZ,
+			jobIP,
+		)
+	}
+}
+
 func (c *JobsCollector) jobHealthyMetrics(
 	healthy bool,
 	deploymentName string,
@@ -249,20 +360,98 @@ func (c *JobsCollector) jobHealthyMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) {
 	var healthyMetric float64
 	if healthy {
 		healthyMetric = 1
 	}
 
-	c.jobHealthyMetric.WithLabelValues(
+	ch <- newGaugeMetric(
+		c.jobHealthyDesc,
+		healthyMetric,
+		deploymentName,
+		jobName,
+		jobID,
+		jobIndex,
+		jobAZ,
+		jobIP,
+	)
+}
+
+func (c *JobsCollector) jobResurrectionPausedMetrics(
+	resurrectionPaused bool,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	var resurrectionPausedMetric float64
+	if resurrectionPaused {
+		resurrectionPausedMetric = 1
+	}
+
+	ch <- newGaugeMetric(
+		c.jobResurrectionPausedDesc,
+		resurrectionPausedMetric,
 		deploymentName,
 		jobName,
 		jobID,
 		jobIndex,
 		jobAZ,
 		jobIP,
-	).Set(healthyMetric)
+	)
+}
+
+func (c *JobsCollector) jobUptimeMetrics(
+	uptime *uint64,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	if uptime != nil {
+		ch <- newGaugeMetric(
+			c.jobUptimeDesc,
+			float64(*uptime),
+			deploymentName,
+			jobName,
+			jobID,
+			jobIndex,
+			jobAZ,
+			jobIP,
+		)
+	}
+}
+
+func (c *JobsCollector) jobVMCreatedTimestampMetrics(
+	vmCreatedAt time.Time,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	ch chan<- prometheus.Metric,
+) {
+	if !vmCreatedAt.IsZero() {
+		ch <- newGaugeMetric(
+			c.jobVMCreatedTimestampDesc,
+			float64(vmCreatedAt.Unix()),
+			deploymentName,
+			jobName,
+			jobID,
+			jobIndex,
+			jobAZ,
+			jobIP,
+		)
+	}
 }
 
 func (c *JobsCollector) jobLoadAvgMetrics(
@@ -273,6 +462,7 @@ func (c *JobsCollector) jobLoadAvgMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err  error
@@ -285,14 +475,16 @@ func (c *JobsCollector) jobLoadAvgMetrics(
 			if err != nil {
 				err = fmt.Errorf("error while converting Load avg01 metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 			} else {
-				c.jobLoadAvg01Metric.WithLabelValues(
+				ch <- newGaugeMetric(
+					c.jobLoadAvg01Desc,
+					load,
 					deploymentName,
 					jobName,
 					jobID,
 					jobIndex,
 					jobAZ,
 					jobIP,
-				).Set(load)
+				)
 			}
 		}
 
@@ -301,14 +493,16 @@ func (c *JobsCollector) jobLoadAvgMetrics(
 			if err != nil {
 				err = fmt.Errorf("error while converting Load avg05 metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 			} else {
-				c.jobLoadAvg05Metric.WithLabelValues(
+				ch <- newGaugeMetric(
+					c.jobLoadAvg05Desc,
+					load,
 					deploymentName,
 					jobName,
 					jobID,
 					jobIndex,
 					jobAZ,
 					jobIP,
-				).Set(load)
+				)
 			}
 		}
 
@@ -317,14 +511,16 @@ func (c *JobsCollector) jobLoadAvgMetrics(
 			if err != nil {
 				err = fmt.Errorf("error while converting Load avg15 metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 			} else {
-				c.jobLoadAvg15Metric.WithLabelValues(
+				ch <- newGaugeMetric(
+					c.jobLoadAvg15Desc,
+					load,
 					deploymentName,
 					jobName,
 					jobID,
 					jobIndex,
 					jobAZ,
 					jobIP,
-				).Set(load)
+				)
 			}
 		}
 	}
@@ -340,6 +536,7 @@ func (c *JobsCollector) jobCPUMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err  error
@@ -351,14 +548,16 @@ func (c *JobsCollector) jobCPUMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting CPU Sys metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobCPUSysMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobCPUSysDesc,
+				load,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(load)
+			)
 		}
 	}
 
@@ -367,14 +566,16 @@ func (c *JobsCollector) jobCPUMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting CPU User metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobCPUUserMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobCPUUserDesc,
+				load,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(load)
+			)
 		}
 	}
 
@@ -383,14 +584,16 @@ func (c *JobsCollector) jobCPUMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting CPU Wait metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobCPUWaitMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobCPUWaitDesc,
+				load,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(load)
+			)
 		}
 	}
 
@@ -405,6 +608,7 @@ func (c *JobsCollector) jobMemMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err   error
@@ -416,14 +620,16 @@ func (c *JobsCollector) jobMemMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Mem KB metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobMemKBMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobMemKBDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -432,14 +638,16 @@ func (c *JobsCollector) jobMemMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Mem Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobMemPercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobMemPercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -454,6 +662,7 @@ func (c *JobsCollector) jobSwapMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err   error
@@ -465,14 +674,16 @@ func (c *JobsCollector) jobSwapMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Swap KB metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobSwapKBMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobSwapKBDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -481,14 +692,16 @@ func (c *JobsCollector) jobSwapMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Swap Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobSwapPercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobSwapPercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -503,6 +716,7 @@ func (c *JobsCollector) jobSystemDiskMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err   error
@@ -514,14 +728,16 @@ func (c *JobsCollector) jobSystemDiskMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting System Disk Inode Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobSystemDiskInodePercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobSystemDiskInodePercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -530,14 +746,16 @@ func (c *JobsCollector) jobSystemDiskMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting System Disk Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobSystemDiskPercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobSystemDiskPercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -552,6 +770,7 @@ func (c *JobsCollector) jobEphemeralDiskMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err   error
@@ -563,14 +782,16 @@ func (c *JobsCollector) jobEphemeralDiskMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Ephemeral Disk Inode Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobEphemeralDiskInodePercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobEphemeralDiskInodePercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -579,14 +800,16 @@ func (c *JobsCollector) jobEphemeralDiskMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Ephemeral Disk Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobEphemeralDiskPercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobEphemeralDiskPercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -601,6 +824,7 @@ func (c *JobsCollector) jobPersistentDiskMetrics(
 	jobIndex string,
 	jobAZ string,
 	jobIP string,
+	ch chan<- prometheus.Metric,
 ) error {
 	var (
 		err   error
@@ -612,14 +836,16 @@ func (c *JobsCollector) jobPersistentDiskMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Persistent Disk Inode Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobPersistentDiskInodePercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobPersistentDiskInodePercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -628,14 +854,16 @@ func (c *JobsCollector) jobPersistentDiskMetrics(
 		if err != nil {
 			err = fmt.Errorf("error while converting Persistent Disk Percent metric for deployment `%s` and job `%s`: %v", deploymentName, jobName, err)
 		} else {
-			c.jobPersistentDiskPercentMetric.WithLabelValues(
+			ch <- newGaugeMetric(
+				c.jobPersistentDiskPercentDesc,
+				value,
 				deploymentName,
 				jobName,
 				jobID,
 				jobIndex,
 				jobAZ,
 				jobIP,
-			).Set(value)
+			)
 		}
 	}
 
@@ -651,8 +879,11 @@ func (c *JobsCollector) jobProcessInfoMetrics(
 	jobIP string,
 	jobProcessName string,
 	jobProcessRelease deployments.Release,
+	ch chan<- prometheus.Metric,
 ) {
-	c.jobProcessInfoMetric.WithLabelValues(
+	ch <- newGaugeMetric(
+		c.jobProcessInfoDesc,
+		1,
 		deploymentName,
 		jobName,
 		jobID,
@@ -662,7 +893,7 @@ func (c *JobsCollector) jobProcessInfoMetrics(
 		jobProcessName,
 		jobProcessRelease.Name,
 		jobProcessRelease.Version,
-	).Set(1)
+	)
 }
 
 func (c *JobsCollector) jobProcessHealthyMetrics(
@@ -674,13 +905,16 @@ func (c *JobsCollector) jobProcessHealthyMetrics(
 	jobAZ string,
 	jobIP string,
 	jobProcessName string,
+	ch chan<- prometheus.Metric,
 ) {
 	var healthyMetric float64
 	if healthy {
 		healthyMetric = 1
 	}
 
-	c.jobProcessHealthyMetric.WithLabelValues(
+	ch <- newGaugeMetric(
+		c.jobProcessHealthyDesc,
+		healthyMetric,
 		deploymentName,
 		jobName,
 		jobID,
@@ -688,7 +922,21 @@ func (c *JobsCollector) jobProcessHealthyMetrics(
 		jobAZ,
 		jobIP,
 		jobProcessName,
-	).Set(healthyMetric)
+	)
+}
+
+func (c *JobsCollector) jobProcessStateMetrics(
+	state string,
+	deploymentName string,
+	jobName string,
+	jobID string,
+	jobIndex string,
+	jobAZ string,
+	jobIP string,
+	jobProcessName string,
+	ch chan<- prometheus.Metric,
+) {
+	stateSetMetrics(ch, c.jobProcessStateDesc, jobProcessStates, state, deploymentName, jobName, jobID, jobIndex, jobAZ, jobIP, jobProcessName)
 }
 
 func (c *JobsCollector) jobProcessUptimeMetrics(
@@ -700,9 +948,12 @@ func (c *JobsCollector) jobProcessUptimeMetrics(
 	jobAZ string,
 	jobIP string,
 	jobProcessName string,
+	ch chan<- prometheus.Metric,
 ) {
 	if uptime != nil {
-		c.jobProcessUptimeMetric.WithLabelValues(
+		ch <- newGaugeMetric(
+			c.jobProcessUptimeDesc,
+			float64(*uptime),
 			deploymentName,
 			jobName,
 			jobID,
@@ -710,7 +961,7 @@ func (c *JobsCollector) jobProcessUptimeMetrics(
 			jobAZ,
 			jobIP,
 			jobProcessName,
-		).Set(float64(*uptime))
+		)
 	}
 }
 
@@ -723,9 +974,12 @@ func (c *JobsCollector) jobProcessCPUMetrics(
 	jobAZ string,
 	jobIP string,
 	jobProcessName string,
+	ch chan<- prometheus.Metric,
 ) {
 	if cpu.Total != nil {
-		c.jobProcessCPUTotalMetric.WithLabelValues(
+		ch <- newGaugeMetric(
+			c.jobProcessCPUTotalDesc,
+			*cpu.Total,
 			deploymentName,
 			jobName,
 			jobID,
@@ -733,7 +987,7 @@ func (c *JobsCollector) jobProcessCPUMetrics(
 			jobAZ,
 			jobIP,
 			jobProcessName,
-		).Set(*cpu.Total)
+		)
 	}
 }
 
@@ -746,9 +1000,12 @@ func (c *JobsCollector) jobProcessMemMetrics(
 	jobAZ string,
 	jobIP string,
 	jobProcessName string,
+	ch chan<- prometheus.Metric,
 ) {
 	if mem.KB != nil {
-		c.jobProcessMemKBMetric.WithLabelValues(
+		ch <- newGaugeMetric(
+			c.jobProcessMemKBDesc,
+			float64(*mem.KB),
 			deploymentName,
 			jobName,
 			jobID,
@@ -756,11 +1013,13 @@ func (c *JobsCollector) jobProcessMemMetrics(
 			jobAZ,
 			jobIP,
 			jobProcessName,
-		).Set(float64(*mem.KB))
+		)
 	}
 
 	if mem.Percent != nil {
-		c.jobProcessMemPercentMetric.WithLabelValues(
+		ch <- newGaugeMetric(
+			c.jobProcessMemPercentDesc,
+			*mem.Percent,
 			deploymentName,
 			jobName,
 			jobID,
@@ -768,6 +1027,28 @@ func (c *JobsCollector) jobProcessMemMetrics(
 			jobAZ,
 			jobIP,
 			jobProcessName,
-		).Set(*mem.Percent)
+		)
+	}
+}
+
+// stateSetMetrics reports one series per known state, set to 1 for the current state and 0 for the others.
+// An unknown current state is reported as an additional series so that it is never lost.
+func stateSetMetrics(ch chan<- prometheus.Metric, desc *prometheus.Desc, states []string, state string, labelValues ...string) {
+	if state == "" {
+		return
+	}
+
+	known := false
+	for _, knownState := range states {
+		var stateMetric float64
+		if knownState == state {
+			stateMetric = 1
+			known = true
+		}
+		ch <- newGaugeMetric(desc, stateMetric, append(labelValues, knownState)...)
+	}
+
+	if !known {
+		ch <- newGaugeMetric(desc, 1, append(labelValues, state)...)
 	}
 }
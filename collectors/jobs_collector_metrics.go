@@ -447,3 +447,156 @@ func (m *JobsCollectorMetrics) NewJobHealthyMetric() *prometheus.GaugeVec {
 		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip"},
 	)
 }
+
+func (m *JobsCollectorMetrics) NewJobUptimeMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "uptime_seconds",
+			Help:      "BOSH Job VM Uptime in seconds.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobResurrectionPausedMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "resurrection_paused",
+			Help:      "BOSH Job Resurrection Paused (1 for paused, 0 for not paused).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobInfoMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "info",
+			Help:      "BOSH Job Info with a constant '1' value.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip", "bosh_job_agent_id", "bosh_vm_cid", "bosh_job_vm_type", "bosh_job_resource_pool", "bosh_job_bootstrap"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobVMCreatedTimestampMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "vm_created_timestamp",
+			Help:      "Number of seconds since 1970 since the BOSH Job VM was created.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobInstanceStateMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "instance_state",
+			Help:      "BOSH Job Instance State (1 for the current state, 0 otherwise), reported also for instances without a VM.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip", "bosh_job_instance_state"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobIgnoreMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "ignore",
+			Help:      "BOSH Job Ignore flag (1 for ignored, 0 for not ignored).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobStateMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "state",
+			Help:      "BOSH Job State (1 for the current state, 0 otherwise).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip", "bosh_job_state"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobActiveMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job",
+			Name:      "active",
+			Help:      "BOSH Job VM Active flag (1 for active, 0 for inactive).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip"},
+	)
+}
+
+func (m *JobsCollectorMetrics) NewJobProcessStateMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "job_process",
+			Name:      "state",
+			Help:      "BOSH Job Process State (1 for the current state, 0 otherwise).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_id", "bosh_job_index", "bosh_job_az", "bosh_job_ip", "bosh_job_process_name", "bosh_job_process_state"},
+	)
+}
@@ -1,12 +1,19 @@
 package collectors_test
 
 import (
+	"context"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 
 	"github.com/cloudfoundry/bosh_exporter/deployments"
 	"github.com/cloudfoundry/bosh_exporter/filters"
@@ -42,7 +49,15 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 		metrics       *collectors.JobsCollectorMetrics
 		jobsCollector *collectors.JobsCollector
 
+		jobInfoMetric                       *prometheus.GaugeVec
+		jobInstanceStateMetric              *prometheus.GaugeVec
+		jobIgnoreMetric                     *prometheus.GaugeVec
+		jobStateMetric                      *prometheus.GaugeVec
+		jobActiveMetric                     *prometheus.GaugeVec
 		jobHealthyMetric                    *prometheus.GaugeVec
+		jobResurrectionPausedMetric         *prometheus.GaugeVec
+		jobUptimeMetric                     *prometheus.GaugeVec
+		jobVMCreatedTimestampMetric         *prometheus.GaugeVec
 		jobLoadAvg01Metric                  *prometheus.GaugeVec
 		jobLoadAvg05Metric                  *prometheus.GaugeVec
 		jobLoadAvg15Metric                  *prometheus.GaugeVec
@@ -61,6 +76,7 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 		jobPersistentDiskPercentMetric      *prometheus.GaugeVec
 		jobProcessInfoMetric                *prometheus.GaugeVec
 		jobProcessHealthyMetric             *prometheus.GaugeVec
+		jobProcessStateMetric               *prometheus.GaugeVec
 		jobProcessUptimeMetric              *prometheus.GaugeVec
 		jobProcessCPUTotalMetric            *prometheus.GaugeVec
 		jobProcessMemKBMetric               *prometheus.GaugeVec
@@ -76,7 +92,19 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			jobIP:          "1.2.3.4",
 			jobAZ:          "fake-job-az",
 		}
+		jobAgentID                    = "fake-agent-id"
+		jobVMCID                      = "fake-vm-cid"
+		jobVMType                     = "fake-vm-type"
+		jobResourcePool               = "fake-resource-pool"
+		jobBootstrap                  = true
+		jobInstanceState              = "started"
+		jobState                      = "running"
+		jobActive                     = true
+		jobIgnore                     = false
 		jobHealthy                    = true
+		jobResurrectionPaused         = true
+		jobUptime                     = uint64(7200)
+		jobVMCreatedAt                = time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
 		jobCPUSys                     = float64(0.5)
 		jobCPUUser                    = float64(1.0)
 		jobCPUWait                    = float64(1.5)
@@ -96,6 +124,7 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 		jobProcessName                = "fake-process-name"
 		jobProcessUptime              = uint64(3600)
 		jobProcessHealthy             = true
+		jobProcessState               = "running"
 		jobProcessCPUTotal            = float64(0.5)
 		jobProcessMemKB               = uint64(2000)
 		jobProcessMemPercent          = float64(20)
@@ -113,9 +142,35 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 		cidrsFilter, err = filters.NewCidrFilter([]string{"0.0.0.0/0"})
 		gomega.Expect(err).ToNot(gomega.HaveOccurred())
 
+		jobInfoMetric = metrics.NewJobInfoMetric()
+		baseLabelValues.AddLabelValues(jobInfoMetric, jobAgentID, jobVMCID, jobVMType, jobResourcePool, "true").Set(float64(1))
+
+		jobInstanceStateMetric = metrics.NewJobInstanceStateMetric()
+		baseLabelValues.AddLabelValues(jobInstanceStateMetric, jobInstanceState).Set(float64(1))
+		baseLabelValues.AddLabelValues(jobInstanceStateMetric, "stopped").Set(float64(0))
+
+		jobIgnoreMetric = metrics.NewJobIgnoreMetric()
+		baseLabelValues.AddLabelValues(jobIgnoreMetric).Set(float64(0))
+
+		jobStateMetric = metrics.NewJobStateMetric()
+		baseLabelValues.AddLabelValues(jobStateMetric, jobState).Set(float64(1))
+		baseLabelValues.AddLabelValues(jobStateMetric, "failing").Set(float64(0))
+
+		jobActiveMetric = metrics.NewJobActiveMetric()
+		baseLabelValues.AddLabelValues(jobActiveMetric).Set(float64(1))
+
 		jobHealthyMetric = metrics.NewJobHealthyMetric()
 		baseLabelValues.AddLabelValues(jobHealthyMetric).Set(float64(1))
 
+		jobResurrectionPausedMetric = metrics.NewJobResurrectionPausedMetric()
+		baseLabelValues.AddLabelValues(jobResurrectionPausedMetric).Set(float64(1))
+
+		jobUptimeMetric = metrics.NewJobUptimeMetric()
+		baseLabelValues.AddLabelValues(jobUptimeMetric).Set(float64(jobUptime))
+
+		jobVMCreatedTimestampMetric = metrics.NewJobVMCreatedTimestampMetric()
+		baseLabelValues.AddLabelValues(jobVMCreatedTimestampMetric).Set(float64(jobVMCreatedAt.Unix()))
+
 		jobLoadAvg01Metric = metrics.NewJobLoadAvg01Metric()
 		baseLabelValues.AddLabelValues(jobLoadAvg01Metric).Set(jobLoadAvg01)
 
@@ -170,6 +225,10 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 		jobProcessHealthyMetric = metrics.NewJobProcessHealthyMetric()
 		baseLabelValues.AddLabelValues(jobProcessHealthyMetric, jobProcessName).Set(float64(1))
 
+		jobProcessStateMetric = metrics.NewJobProcessStateMetric()
+		baseLabelValues.AddLabelValues(jobProcessStateMetric, jobProcessName, jobProcessState).Set(float64(1))
+		baseLabelValues.AddLabelValues(jobProcessStateMetric, jobProcessName, "unmonitored").Set(float64(0))
+
 		jobProcessUptimeMetric = metrics.NewJobProcessUptimeMetric()
 		baseLabelValues.AddLabelValues(jobProcessUptimeMetric, jobProcessName).Set(float64(jobProcessUptime))
 
@@ -203,10 +262,42 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			go jobsCollector.Describe(descriptions)
 		})
 
+		ginkgo.It("returns a job_info metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobInfoMetric, jobAgentID, jobVMCID, jobVMType, jobResourcePool, "true").Desc())))
+		})
+
+		ginkgo.It("returns a job_instance_state metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobInstanceStateMetric, jobInstanceState).Desc())))
+		})
+
+		ginkgo.It("returns a job_ignore metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobIgnoreMetric).Desc())))
+		})
+
+		ginkgo.It("returns a job_state metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobStateMetric, jobState).Desc())))
+		})
+
+		ginkgo.It("returns a job_active metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobActiveMetric).Desc())))
+		})
+
 		ginkgo.It("returns a job_healthy metric description", func() {
 			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobHealthyMetric).Desc())))
 		})
 
+		ginkgo.It("returns a job_resurrection_paused metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobResurrectionPausedMetric).Desc())))
+		})
+
+		ginkgo.It("returns a job_uptime_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobUptimeMetric).Desc())))
+		})
+
+		ginkgo.It("returns a job_vm_created_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobVMCreatedTimestampMetric).Desc())))
+		})
+
 		ginkgo.It("returns a job_load_avg01 metric description", func() {
 			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobLoadAvg01Metric).Desc())))
 		})
@@ -279,6 +370,10 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobProcessHealthyMetric, jobProcessName).Desc())))
 		})
 
+		ginkgo.It("returns a job_process_state metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobProcessStateMetric, jobProcessName, jobProcessState).Desc())))
+		})
+
 		ginkgo.It("returns a job_process_uptime_seconds metric description", func() {
 			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(baseLabelValues.AddLabelValues(jobProcessUptimeMetric, jobProcessName).Desc())))
 		})
@@ -320,6 +415,7 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			processes = []deployments.Process{
 				{
 					Name:    jobProcessName,
+					State:   jobProcessState,
 					Uptime:  &jobProcessUptime,
 					Healthy: jobProcessHealthy,
 					CPU:     deployments.CPU{Total: &jobProcessCPUTotal},
@@ -341,6 +437,7 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 					KB:      strconv.Itoa(jobSwapKB),
 					Percent: strconv.Itoa(jobSwapPercent),
 				},
+				Uptime: &jobUptime,
 				Load: []string{
 					strconv.FormatFloat(jobLoadAvg01, 'E', -1, 64),
 					strconv.FormatFloat(jobLoadAvg05, 'E', -1, 64),
@@ -362,14 +459,25 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 
 			instances = []deployments.Instance{
 				{
-					Name:      baseLabelValues.jobName,
-					ID:        baseLabelValues.jobID,
-					Index:     baseLabelValues.jobIndex,
-					IPs:       []string{baseLabelValues.jobIP},
-					AZ:        baseLabelValues.jobAZ,
-					Healthy:   jobHealthy,
-					Vitals:    vitals,
-					Processes: processes,
+					AgentID:            jobAgentID,
+					VMID:               jobVMCID,
+					Name:               baseLabelValues.jobName,
+					ID:                 baseLabelValues.jobID,
+					Index:              baseLabelValues.jobIndex,
+					Bootstrap:          jobBootstrap,
+					IPs:                []string{baseLabelValues.jobIP},
+					AZ:                 baseLabelValues.jobAZ,
+					State:              jobInstanceState,
+					ProcessState:       jobState,
+					Active:             &jobActive,
+					Ignore:             jobIgnore,
+					VMType:             jobVMType,
+					ResourcePool:       jobResourcePool,
+					ResurrectionPaused: jobResurrectionPaused,
+					VMCreatedAt:        jobVMCreatedAt,
+					Healthy:            jobHealthy,
+					Vitals:             vitals,
+					Processes:          processes,
 				},
 			}
 
@@ -380,16 +488,107 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 
 			deploymentsInfo = []deployments.DeploymentInfo{deploymentInfo}
 
-			metrics = make(chan prometheus.Metric)
-			errMetrics = make(chan error, 1)
 		})
 
 		ginkgo.JustBeforeEach(func() {
-			go func() {
-				if err := jobsCollector.Collect(deploymentsInfo, metrics); err != nil {
-					errMetrics <- err
+			metrics, errMetrics = collectInBackground(context.Background(), jobsCollector, deploymentsInfo)
+		})
+
+		ginkgo.It("returns a job_info metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobInfoMetric, jobAgentID, jobVMCID, jobVMType, jobResourcePool, "true"))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a job_instance_state metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobInstanceStateMetric, jobInstanceState))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a job_instance_state metric for the other instance states", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobInstanceStateMetric, "stopped"))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a job_ignore metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobIgnoreMetric))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a job_state metric for the current state", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobStateMetric, jobState))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a job_state metric for the other states", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobStateMetric, "failing"))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when the job state is not a known state", func() {
+			ginkgo.BeforeEach(func() {
+				instances[0].ProcessState = "fake-job-state"
+				baseLabelValues.AddLabelValues(jobStateMetric, "fake-job-state").Set(float64(1))
+				baseLabelValues.AddLabelValues(jobStateMetric, jobState).Set(float64(0))
+			})
+
+			ginkgo.It("returns a job_state metric for the unknown state", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobStateMetric, "fake-job-state"))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+
+			ginkgo.It("returns a job_state metric for the known states", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobStateMetric, jobState))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.It("returns a job_active metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobActiveMetric))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when there is no active value", func() {
+			ginkgo.BeforeEach(func() {
+				instances[0].Active = nil
+			})
+
+			ginkgo.It("does not return a job_active metric", func() {
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobActiveMetric))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.Context("when the instance has no VM", func() {
+			ginkgo.BeforeEach(func() {
+				instances[0].VMID = ""
+				instances[0].State = "stopped"
+				baseLabelValues.AddLabelValues(jobInstanceStateMetric, "stopped").Set(float64(1))
+			})
+
+			ginkgo.It("returns a job_instance_state metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobInstanceStateMetric, "stopped"))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+
+			ginkgo.It("does not return vitals metrics", func() {
+				collected := make(chan prometheus.Metric, 1000)
+				gomega.Expect(jobsCollector.Collect(context.Background(), deploymentsInfo, collected)).To(gomega.Succeed())
+				close(collected)
+
+				vitalsDescs := []string{
+					baseLabelValues.AddLabelValues(jobHealthyMetric).Desc().String(),
+					baseLabelValues.AddLabelValues(jobCPUSysMetric).Desc().String(),
+					baseLabelValues.AddLabelValues(jobProcessHealthyMetric, jobProcessName).Desc().String(),
+				}
+				var descs []string
+				for metric := range collected {
+					descs = append(descs, metric.Desc().String())
 				}
-			}()
+				gomega.Expect(descs).ToNot(gomega.BeEmpty())
+				for _, vitalsDesc := range vitalsDescs {
+					gomega.Expect(descs).ToNot(gomega.ContainElement(vitalsDesc))
+				}
+			})
 		})
 
 		ginkgo.It("returns a job_process_healthy metric", func() {
@@ -409,6 +608,55 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			})
 		})
 
+		ginkgo.It("returns a job_resurrection_paused metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobResurrectionPausedMetric))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when the resurrection is not paused", func() {
+			ginkgo.BeforeEach(func() {
+				instances[0].ResurrectionPaused = false
+				baseLabelValues.AddLabelValues(jobResurrectionPausedMetric).Set(float64(0))
+			})
+
+			ginkgo.It("returns a job_resurrection_paused metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobResurrectionPausedMetric))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.It("returns a job_uptime_seconds metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobUptimeMetric))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when there is no uptime value", func() {
+			ginkgo.BeforeEach(func() {
+				instances[0].Vitals.Uptime = nil
+			})
+
+			ginkgo.It("does not return a job_uptime_seconds metric", func() {
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobUptimeMetric))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.It("returns a job_vm_created_timestamp metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobVMCreatedTimestampMetric))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when there is no vm created at value", func() {
+			ginkgo.BeforeEach(func() {
+				instances[0].VMCreatedAt = time.Time{}
+			})
+
+			ginkgo.It("does not return a job_vm_created_timestamp metric", func() {
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobVMCreatedTimestampMetric))))
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
 		ginkgo.It("returns a job_load_avg01 metric", func() {
 			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobLoadAvg01Metric))))
 			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
@@ -691,6 +939,16 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			})
 		})
 
+		ginkgo.It("returns a job_process_state metric for the current state", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobProcessStateMetric, jobProcessName, jobProcessState))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a job_process_state metric for the other states", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobProcessStateMetric, jobProcessName, "unmonitored"))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
 		ginkgo.It("returns a job_process_uptime_seconds metric", func() {
 			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(baseLabelValues.AddLabelValues(jobProcessUptimeMetric, jobProcessName))))
 			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
@@ -767,6 +1025,76 @@ var _ = ginkgo.Describe("JobsCollector", func() {
 			})
 		})
 
+		ginkgo.Context("when it is scraped concurrently", func() {
+			var (
+				scrapes = 20
+			)
+
+			// scrape returns the sorted series of a single scrape, leaving out the last_jobs_scrape_* metrics
+			// as they differ between scrapes.
+			scrape := func() ([]string, error) {
+				collected := make(chan prometheus.Metric)
+				errCollect := make(chan error, 1)
+				go func() {
+					errCollect <- jobsCollector.Collect(context.Background(), deploymentsInfo, collected)
+					close(collected)
+				}()
+
+				var series []string
+				for metric := range collected {
+					desc := metric.Desc().String()
+					if strings.Contains(desc, "last_jobs_scrape_") {
+						continue
+					}
+					m := &dto.Metric{}
+					if err := metric.Write(m); err != nil {
+						return nil, err
+					}
+					series = append(series, desc+" "+m.String())
+				}
+				sort.Strings(series)
+
+				return series, <-errCollect
+			}
+
+			ginkgo.BeforeEach(func() {
+				deploymentsInfo = []deployments.DeploymentInfo{}
+				for i := 0; i < 5; i++ {
+					deploymentInfo.Name = fmt.Sprintf("%s-%d", baseLabelValues.deploymentName, i)
+					deploymentsInfo = append(deploymentsInfo, deploymentInfo)
+				}
+			})
+
+			ginkgo.It("returns the same metrics on every scrape", func() {
+				expectedSeries, err := scrape()
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				gomega.Expect(expectedSeries).ToNot(gomega.BeEmpty())
+
+				var wg sync.WaitGroup
+				results := make(chan []string, scrapes)
+				errs := make(chan error, scrapes)
+				for i := 0; i < scrapes; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						series, err := scrape()
+						results <- series
+						errs <- err
+					}()
+				}
+				wg.Wait()
+				close(results)
+				close(errs)
+
+				for err := range errs {
+					gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				}
+				for series := range results {
+					gomega.Expect(series).To(gomega.Equal(expectedSeries))
+				}
+			})
+		})
+
 		ginkgo.Context("when there are no deployments", func() {
 			ginkgo.BeforeEach(func() {
 				deploymentsInfo = []deployments.DeploymentInfo{}
@@ -0,0 +1,164 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
+)
+
+type OrphansCollector struct {
+	boshClient                           director.Director
+	orphanedDisksDesc                    *prometheus.Desc
+	orphanedDisksSizeMBDesc              *prometheus.Desc
+	orphanedDiskAgeSecondsDesc           *prometheus.Desc
+	orphanedVMsDesc                      *prometheus.Desc
+	orphanedVMAgeSecondsDesc             *prometheus.Desc
+	lastOrphansScrapeTimestampDesc       *prometheus.Desc
+	lastOrphansScrapeDurationSecondsDesc *prometheus.Desc
+}
+
+// orphansGroup identifies the instance group orphaned disks and VMs are aggregated by.
+type orphansGroup struct {
+	deploymentName string
+	jobName        string
+	azName         string
+}
+
+func NewOrphansCollector(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+	boshClient director.Director,
+) *OrphansCollector {
+	metrics := NewOrphansCollectorMetrics(namespace, environment, boshName, boshUUID)
+	collector := &OrphansCollector{
+		boshClient:                           boshClient,
+		orphanedDisksDesc:                    newDesc(metrics.NewOrphanedDisksMetric()),
+		orphanedDisksSizeMBDesc:              newDesc(metrics.NewOrphanedDisksSizeMBMetric()),
+		orphanedDiskAgeSecondsDesc:           newDesc(metrics.NewOrphanedDiskAgeSecondsMetric()),
+		orphanedVMsDesc:                      newDesc(metrics.NewOrphanedVMsMetric()),
+		orphanedVMAgeSecondsDesc:             newDesc(metrics.NewOrphanedVMAgeSecondsMetric()),
+		lastOrphansScrapeTimestampDesc:       newDesc(metrics.NewLastOrphansScrapeTimestampMetric()),
+		lastOrphansScrapeDurationSecondsDesc: newDesc(metrics.NewLastOrphansScrapeDurationSecondsMetric()),
+	}
+	return collector
+}
+
+func (c *OrphansCollector) Collect(ctx context.Context, _ []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+	var begun = time.Now()
+
+	disks, err := ctxcall.Do(ctx, c.boshClient.OrphanDisks)
+	if err != nil {
+		err = fmt.Errorf("error while reading orphaned disks: %v", err)
+	} else {
+		c.reportOrphanedDisksMetrics(disks, begun, ch)
+	}
+
+	vms, vmsErr := ctxcall.Do(ctx, c.boshClient.OrphanedVMs)
+	if vmsErr != nil {
+		if err == nil {
+			err = fmt.Errorf("error while reading orphaned VMs: %v", vmsErr)
+		}
+	} else {
+		c.reportOrphanedVMsMetrics(vms, begun, ch)
+	}
+
+	ch <- newGaugeMetric(c.lastOrphansScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastOrphansScrapeDurationSecondsDesc, time.Since(begun).Seconds())
+
+	return err
+}
+
+func (c *OrphansCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.orphanedDisksDesc
+	ch <- c.orphanedDisksSizeMBDesc
+	ch <- c.orphanedDiskAgeSecondsDesc
+	ch <- c.orphanedVMsDesc
+	ch <- c.orphanedVMAgeSecondsDesc
+	ch <- c.lastOrphansScrapeTimestampDesc
+	ch <- c.lastOrphansScrapeDurationSecondsDesc
+}
+
+func (c *OrphansCollector) reportOrphanedDisksMetrics(disks []director.OrphanDisk, now time.Time, ch chan<- prometheus.Metric) {
+	var groups []orphansGroup
+	disksCount := map[orphansGroup]float64{}
+	disksSizeMB := map[orphansGroup]float64{}
+
+	for _, disk := range disks {
+		deploymentName := ""
+		if deployment := disk.Deployment(); deployment != nil {
+			deploymentName = deployment.Name()
+		}
+		group := orphansGroup{
+			deploymentName: deploymentName,
+			jobName:        instanceGroupName(disk.InstanceName()),
+			azName:         disk.AZName(),
+		}
+
+		if _, ok := disksCount[group]; !ok {
+			groups = append(groups, group)
+		}
+		disksCount[group]++
+		disksSizeMB[group] += float64(disk.Size())
+
+		ch <- newGaugeMetric(
+			c.orphanedDiskAgeSecondsDesc,
+			ageSeconds(disk.OrphanedAt(), now),
+			group.deploymentName,
+			group.jobName,
+			group.azName,
+			disk.CID(),
+		)
+	}
+
+	for _, group := range groups {
+		ch <- newGaugeMetric(c.orphanedDisksDesc, disksCount[group], group.deploymentName, group.jobName, group.azName)
+		ch <- newGaugeMetric(c.orphanedDisksSizeMBDesc, disksSizeMB[group], group.deploymentName, group.jobName, group.azName)
+	}
+}
+
+func (c *OrphansCollector) reportOrphanedVMsMetrics(vms []director.OrphanedVM, now time.Time, ch chan<- prometheus.Metric) {
+	var groups []orphansGroup
+	vmsCount := map[orphansGroup]float64{}
+
+	for _, vm := range vms {
+		group := orphansGroup{
+			deploymentName: vm.DeploymentName,
+			jobName:        instanceGroupName(vm.InstanceName),
+			azName:         vm.AZName,
+		}
+
+		if _, ok := vmsCount[group]; !ok {
+			groups = append(groups, group)
+		}
+		vmsCount[group]++
+
+		ch <- newGaugeMetric(
+			c.orphanedVMAgeSecondsDesc,
+			ageSeconds(vm.OrphanedAt, now),
+			group.deploymentName,
+			group.jobName,
+			group.azName,
+			vm.CID,
+		)
+	}
+
+	for _, group := range groups {
+		ch <- newGaugeMetric(c.orphanedVMsDesc, vmsCount[group], group.deploymentName, group.jobName, group.azName)
+	}
+}
+
+// instanceGroupName strips the instance ID from an instance name (e.g. "router/0c6a3e8e-...")
+// so orphans are aggregated per instance group.
+func instanceGroupName(instanceName string) string {
+	name, _, _ := strings.Cut(instanceName, "/")
+	return name
+}
@@ -0,0 +1,143 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type OrphansCollectorMetrics struct {
+	namespace   string
+	environment string
+	boshName    string
+	boshUUID    string
+}
+
+func NewOrphansCollectorMetrics(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+) *OrphansCollectorMetrics {
+	return &OrphansCollectorMetrics{
+		namespace:   namespace,
+		environment: environment,
+		boshName:    boshName,
+		boshUUID:    boshUUID,
+	}
+}
+
+func (m *OrphansCollectorMetrics) NewLastOrphansScrapeDurationSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_orphans_scrape_duration_seconds",
+			Help:      "Duration of the last scrape of Orphans metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *OrphansCollectorMetrics) NewLastOrphansScrapeTimestampMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_orphans_scrape_timestamp",
+			Help:      "Number of seconds since 1970 since last scrape of Orphans metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *OrphansCollectorMetrics) NewOrphanedVMAgeSecondsMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "orphaned_vm",
+			Name:      "age_seconds",
+			Help:      "Number of seconds since the BOSH VM was orphaned.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_az", "bosh_vm_cid"},
+	)
+}
+
+func (m *OrphansCollectorMetrics) NewOrphanedVMsMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "orphaned_vms",
+			Help:      "Number of BOSH orphaned VMs.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_az"},
+	)
+}
+
+func (m *OrphansCollectorMetrics) NewOrphanedDiskAgeSecondsMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "orphaned_disk",
+			Name:      "age_seconds",
+			Help:      "Number of seconds since the BOSH persistent disk was orphaned.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_az", "bosh_disk_cid"},
+	)
+}
+
+func (m *OrphansCollectorMetrics) NewOrphanedDisksSizeMBMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "orphaned_disks",
+			Name:      "size_mb",
+			Help:      "Total size in MB of the BOSH orphaned disks.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_az"},
+	)
+}
+
+func (m *OrphansCollectorMetrics) NewOrphanedDisksMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "orphaned_disks",
+			Help:      "Number of BOSH orphaned disks.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_deployment", "bosh_job_name", "bosh_job_az"},
+	)
+}
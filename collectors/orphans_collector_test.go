@@ -0,0 +1,272 @@
+package collectors_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/cloudfoundry/bosh-cli/director/directorfakes"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
+)
+
+var _ = ginkgo.Describe("OrphansCollector", func() {
+	var (
+		namespace        string
+		environment      string
+		boshName         string
+		boshUUID         string
+		boshClient       *directorfakes.FakeDirector
+		metrics          *collectors.OrphansCollectorMetrics
+		orphansCollector *collectors.OrphansCollector
+
+		orphanedDisksMetric                    *prometheus.GaugeVec
+		orphanedDisksSizeMBMetric              *prometheus.GaugeVec
+		orphanedDiskAgeSecondsMetric           *prometheus.GaugeVec
+		orphanedVMsMetric                      *prometheus.GaugeVec
+		orphanedVMAgeSecondsMetric             *prometheus.GaugeVec
+		lastOrphansScrapeTimestampMetric       prometheus.Gauge
+		lastOrphansScrapeDurationSecondsMetric prometheus.Gauge
+
+		deploymentName = "fake-deployment-name"
+		jobName        = "fake-job-name"
+		jobAZ          = "fake-job-az"
+		diskCID        = "fake-disk-cid"
+		vmCID          = "fake-vm-cid"
+	)
+
+	ginkgo.BeforeEach(func() {
+		namespace = testNamespace
+		environment = testEnvironment
+		boshName = testBoshName
+		boshUUID = testBoshUUID
+		boshClient = &directorfakes.FakeDirector{}
+		metrics = collectors.NewOrphansCollectorMetrics(testNamespace, testEnvironment, testBoshName, testBoshUUID)
+
+		orphanedDisksMetric = metrics.NewOrphanedDisksMetric()
+		orphanedDisksSizeMBMetric = metrics.NewOrphanedDisksSizeMBMetric()
+		orphanedDiskAgeSecondsMetric = metrics.NewOrphanedDiskAgeSecondsMetric()
+		orphanedVMsMetric = metrics.NewOrphanedVMsMetric()
+		orphanedVMAgeSecondsMetric = metrics.NewOrphanedVMAgeSecondsMetric()
+		lastOrphansScrapeTimestampMetric = metrics.NewLastOrphansScrapeTimestampMetric()
+		lastOrphansScrapeDurationSecondsMetric = metrics.NewLastOrphansScrapeDurationSecondsMetric()
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		orphansCollector = collectors.NewOrphansCollector(namespace, environment, boshName, boshUUID, boshClient)
+	})
+
+	ginkgo.Describe("Describe", func() {
+		var (
+			descriptions chan *prometheus.Desc
+		)
+
+		ginkgo.BeforeEach(func() {
+			descriptions = make(chan *prometheus.Desc)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			go orphansCollector.Describe(descriptions)
+		})
+
+		ginkgo.It("returns an orphaned_disks metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(orphanedDisksMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+			).Desc())))
+		})
+
+		ginkgo.It("returns an orphaned_disks_size_mb metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(orphanedDisksSizeMBMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+			).Desc())))
+		})
+
+		ginkgo.It("returns an orphaned_disk_age_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(orphanedDiskAgeSecondsMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+				diskCID,
+			).Desc())))
+		})
+
+		ginkgo.It("returns an orphaned_vms metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(orphanedVMsMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+			).Desc())))
+		})
+
+		ginkgo.It("returns an orphaned_vm_age_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(orphanedVMAgeSecondsMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+				vmCID,
+			).Desc())))
+		})
+
+		ginkgo.It("returns a last_orphans_scrape_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastOrphansScrapeTimestampMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_orphans_scrape_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastOrphansScrapeDurationSecondsMetric.Desc())))
+		})
+	})
+
+	ginkgo.Describe("Collect", func() {
+		var (
+			firstDisk  *directorfakes.FakeOrphanDisk
+			secondDisk *directorfakes.FakeOrphanDisk
+			deployment *directorfakes.FakeDeployment
+
+			metrics    chan prometheus.Metric
+			errMetrics chan error
+			ctx        context.Context
+		)
+
+		ginkgo.BeforeEach(func() {
+			deployment = &directorfakes.FakeDeployment{}
+			deployment.NameReturns(deploymentName)
+
+			firstDisk = &directorfakes.FakeOrphanDisk{}
+			firstDisk.CIDReturns(diskCID)
+			firstDisk.SizeReturns(1024)
+			firstDisk.DeploymentReturns(deployment)
+			firstDisk.InstanceNameReturns(jobName + "/fake-job-id")
+			firstDisk.AZNameReturns(jobAZ)
+
+			secondDisk = &directorfakes.FakeOrphanDisk{}
+			secondDisk.CIDReturns("fake-other-disk-cid")
+			secondDisk.SizeReturns(2048)
+			secondDisk.DeploymentReturns(deployment)
+			secondDisk.InstanceNameReturns(jobName + "/fake-other-job-id")
+			secondDisk.AZNameReturns(jobAZ)
+
+			boshClient.OrphanDisksReturns([]director.OrphanDisk{firstDisk, secondDisk}, nil)
+			boshClient.OrphanedVMsReturns([]director.OrphanedVM{
+				{
+					CID:            vmCID,
+					DeploymentName: deploymentName,
+					InstanceName:   jobName + "/fake-job-id",
+					AZName:         jobAZ,
+					OrphanedAt:     time.Now().Add(-time.Hour),
+				},
+			}, nil)
+
+			orphanedDisksMetric.WithLabelValues(deploymentName, jobName, jobAZ).Set(float64(2))
+			orphanedDisksSizeMBMetric.WithLabelValues(deploymentName, jobName, jobAZ).Set(float64(3072))
+			orphanedVMsMetric.WithLabelValues(deploymentName, jobName, jobAZ).Set(float64(1))
+
+			ctx = context.Background()
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			metrics, errMetrics = collectInBackground(ctx, orphansCollector, []deployments.DeploymentInfo{})
+		})
+
+		ginkgo.It("returns an orphaned_disks metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(orphanedDisksMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns an orphaned_disks_size_mb metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(orphanedDisksSizeMBMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns an orphaned_vms metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(orphanedVMsMetric.WithLabelValues(
+				deploymentName,
+				jobName,
+				jobAZ,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when there are no orphans", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.OrphanDisksReturns([]director.OrphanDisk{}, nil)
+				boshClient.OrphanedVMsReturns([]director.OrphanedVM{}, nil)
+			})
+
+			ginkgo.It("returns only a last_orphans_scrape_timestamp & last_orphans_scrape_duration_seconds metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive())
+				gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+			})
+		})
+
+		ginkgo.Context("when the BOSH Director does not answer before the scrape times out", func() {
+			var cancel context.CancelFunc
+
+			ginkgo.BeforeEach(func() {
+				ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+				hang := hangingCall()
+				boshClient.OrphanDisksStub = func() ([]director.OrphanDisk, error) {
+					hang()
+					return nil, nil
+				}
+				boshClient.OrphanedVMsStub = func() ([]director.OrphanedVM, error) {
+					hang()
+					return nil, nil
+				}
+			})
+
+			ginkgo.AfterEach(func() {
+				cancel()
+			})
+
+			ginkgo.It("returns a timeout error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive(gomega.MatchError(gomega.ContainSubstring(context.DeadlineExceeded.Error()))))
+			})
+		})
+
+		ginkgo.Context("when it fails to get the orphaned disks", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.OrphanDisksReturns(nil, errors.New("no orphaned disks"))
+			})
+
+			ginkgo.It("still returns an orphaned_vms metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(orphanedVMsMetric.WithLabelValues(
+					deploymentName,
+					jobName,
+					jobAZ,
+				))))
+			})
+
+			ginkgo.It("returns an error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive())
+			})
+		})
+	})
+})
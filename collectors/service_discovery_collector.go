@@ -1,6 +1,7 @@
 package collectors
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -74,13 +75,13 @@ type TargetGroup struct {
 }
 
 type ServiceDiscoveryCollector struct {
-	serviceDiscoveryFilename                        string
-	azsFilter                                       *filters.AZsFilter
-	processesFilter                                 *filters.RegexpFilter
-	cidrsFilter                                     *filters.CidrFilter
-	lastServiceDiscoveryScrapeTimestampMetric       prometheus.Gauge
-	lastServiceDiscoveryScrapeDurationSecondsMetric prometheus.Gauge
-	mu                                              *sync.Mutex
+	serviceDiscoveryFilename                      string
+	azsFilter                                     *filters.AZsFilter
+	processesFilter                               *filters.RegexpFilter
+	cidrsFilter                                   *filters.CidrFilter
+	lastServiceDiscoveryScrapeTimestampDesc       *prometheus.Desc
+	lastServiceDiscoveryScrapeDurationSecondsDesc *prometheus.Desc
+	mu                                            *sync.Mutex
 }
 
 func NewServiceDiscoveryCollector(
@@ -95,37 +96,36 @@ func NewServiceDiscoveryCollector(
 ) *ServiceDiscoveryCollector {
 	metrics := NewServiceDiscoveryCollectorMetrics(namespace, environment, boshName, boshUUID)
 	collector := &ServiceDiscoveryCollector{
-		serviceDiscoveryFilename: serviceDiscoveryFilename,
-		azsFilter:                azsFilter,
-		processesFilter:          processesFilter,
-		cidrsFilter:              cidrsFilter,
-		lastServiceDiscoveryScrapeTimestampMetric:       metrics.NewLastServiceDiscoveryScrapeTimestampMetric(),
-		lastServiceDiscoveryScrapeDurationSecondsMetric: metrics.NewLastServiceDiscoveryScrapeDurationSecondsMetric(),
+		serviceDiscoveryFilename:                serviceDiscoveryFilename,
+		azsFilter:                               azsFilter,
+		processesFilter:                         processesFilter,
+		cidrsFilter:                             cidrsFilter,
+		lastServiceDiscoveryScrapeTimestampDesc: newDesc(metrics.NewLastServiceDiscoveryScrapeTimestampMetric()),
+		lastServiceDiscoveryScrapeDurationSecondsDesc: newDesc(metrics.NewLastServiceDiscoveryScrapeDurationSecondsMetric()),
 		mu: &sync.Mutex{},
 	}
 	return collector
 }
 
-func (c *ServiceDiscoveryCollector) Collect(deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+func (c *ServiceDiscoveryCollector) Collect(_ context.Context, deployments []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
 	var begun = time.Now()
 
 	labelGroups := c.createLabelGroups(deployments)
 	targetGroups := c.createTargetGroups(labelGroups)
 
+	c.mu.Lock()
 	err := c.writeTargetGroupsToFile(targetGroups)
+	c.mu.Unlock()
 
-	c.lastServiceDiscoveryScrapeTimestampMetric.Set(float64(time.Now().Unix()))
-	c.lastServiceDiscoveryScrapeTimestampMetric.Collect(ch)
-
-	c.lastServiceDiscoveryScrapeDurationSecondsMetric.Set(time.Since(begun).Seconds())
-	c.lastServiceDiscoveryScrapeDurationSecondsMetric.Collect(ch)
+	ch <- newGaugeMetric(c.lastServiceDiscoveryScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastServiceDiscoveryScrapeDurationSecondsDesc, time.Since(begun).Seconds())
 
 	return err
 }
 
 func (c *ServiceDiscoveryCollector) Describe(ch chan<- *prometheus.Desc) {
-	c.lastServiceDiscoveryScrapeTimestampMetric.Describe(ch)
-	c.lastServiceDiscoveryScrapeDurationSecondsMetric.Describe(ch)
+	ch <- c.lastServiceDiscoveryScrapeTimestampDesc
+	ch <- c.lastServiceDiscoveryScrapeDurationSecondsDesc
 }
 
 func (c *ServiceDiscoveryCollector) getLabelGroupKey(
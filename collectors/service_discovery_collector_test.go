@@ -1,6 +1,7 @@
 package collectors_test
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 
@@ -211,16 +212,10 @@ var _ = ginkgo.Describe("ServiceDiscoveryCollector", func() {
 
 			deploymentsInfo = []deployments.DeploymentInfo{deployment1Info, deployment2Info}
 
-			metrics = make(chan prometheus.Metric)
-			errMetrics = make(chan error, 1)
 		})
 
 		ginkgo.JustBeforeEach(func() {
-			go func() {
-				if err := serviceDiscoveryCollector.Collect(deploymentsInfo, metrics); err != nil {
-					errMetrics <- err
-				}
-			}()
+			metrics, errMetrics = collectInBackground(context.Background(), serviceDiscoveryCollector, deploymentsInfo)
 		})
 
 		ginkgo.It("writes a target groups file", func() {
@@ -0,0 +1,168 @@
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
+)
+
+const (
+	taskStateQueued     = "queued"
+	taskStateProcessing = "processing"
+)
+
+type TasksCollector struct {
+	boshClient                          director.Director
+	recentTasksLimit                    int
+	tasksDesc                           *prometheus.Desc
+	tasksOldestQueuedAgeSecondsDesc     *prometheus.Desc
+	tasksOldestProcessingAgeSecondsDesc *prometheus.Desc
+	lastTasksScrapeTimestampDesc        *prometheus.Desc
+	lastTasksScrapeDurationSecondsDesc  *prometheus.Desc
+}
+
+// tasksGroup identifies the series tasks are counted in.
+type tasksGroup struct {
+	state          string
+	deploymentName string
+	taskType       string
+}
+
+func NewTasksCollector(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+	boshClient director.Director,
+	recentTasksLimit int,
+) *TasksCollector {
+	metrics := NewTasksCollectorMetrics(namespace, environment, boshName, boshUUID)
+	collector := &TasksCollector{
+		boshClient:                          boshClient,
+		recentTasksLimit:                    recentTasksLimit,
+		tasksDesc:                           newDesc(metrics.NewTasksMetric()),
+		tasksOldestQueuedAgeSecondsDesc:     newDesc(metrics.NewTasksOldestQueuedAgeSecondsMetric()),
+		tasksOldestProcessingAgeSecondsDesc: newDesc(metrics.NewTasksOldestProcessingAgeSecondsMetric()),
+		lastTasksScrapeTimestampDesc:        newDesc(metrics.NewLastTasksScrapeTimestampMetric()),
+		lastTasksScrapeDurationSecondsDesc:  newDesc(metrics.NewLastTasksScrapeDurationSecondsMetric()),
+	}
+	return collector
+}
+
+func (c *TasksCollector) Collect(ctx context.Context, _ []deployments.DeploymentInfo, ch chan<- prometheus.Metric) error {
+	var begun = time.Now()
+
+	tasks, err := c.fetchTasks(ctx)
+	if err == nil {
+		c.reportTasksMetrics(tasks, begun, ch)
+	}
+
+	ch <- newGaugeMetric(c.lastTasksScrapeTimestampDesc, float64(time.Now().Unix()))
+	ch <- newGaugeMetric(c.lastTasksScrapeDurationSecondsDesc, time.Since(begun).Seconds())
+
+	return err
+}
+
+func (c *TasksCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tasksDesc
+	ch <- c.tasksOldestQueuedAgeSecondsDesc
+	ch <- c.tasksOldestProcessingAgeSecondsDesc
+	ch <- c.lastTasksScrapeTimestampDesc
+	ch <- c.lastTasksScrapeDurationSecondsDesc
+}
+
+func (c *TasksCollector) fetchTasks(ctx context.Context) ([]director.Task, error) {
+	var tasks []director.Task
+	seen := make(map[int]bool)
+
+	currentTasks, err := ctxcall.Do(ctx, func() ([]director.Task, error) {
+		return c.boshClient.CurrentTasks(director.TasksFilter{All: true})
+	})
+	if err != nil {
+		return tasks, fmt.Errorf("error while reading current tasks: %v", err)
+	}
+	for _, task := range currentTasks {
+		seen[task.ID()] = true
+		tasks = append(tasks, task)
+	}
+
+	if c.recentTasksLimit > 0 {
+		recentTasks, err := ctxcall.Do(ctx, func() ([]director.Task, error) {
+			return c.boshClient.RecentTasks(c.recentTasksLimit, director.TasksFilter{All: true})
+		})
+		if err != nil {
+			return tasks, fmt.Errorf("error while reading recent tasks: %v", err)
+		}
+		for _, task := range recentTasks {
+			if seen[task.ID()] {
+				continue
+			}
+			seen[task.ID()] = true
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, nil
+}
+
+func (c *TasksCollector) reportTasksMetrics(tasks []director.Task, now time.Time, ch chan<- prometheus.Metric) {
+	var oldestQueued, oldestProcessing time.Time
+	var groups []tasksGroup
+	tasksCount := map[tasksGroup]float64{}
+
+	for _, task := range tasks {
+		group := tasksGroup{
+			state:          task.State(),
+			deploymentName: task.DeploymentName(),
+			taskType:       taskType(task.Description()),
+		}
+		if _, ok := tasksCount[group]; !ok {
+			groups = append(groups, group)
+		}
+		tasksCount[group]++
+
+		switch task.State() {
+		case taskStateQueued:
+			// queued tasks have not started yet, so the director only reports their creation timestamp
+			if createdAt := task.FinishedAt(); oldestQueued.IsZero() || createdAt.Before(oldestQueued) {
+				oldestQueued = createdAt
+			}
+		case taskStateProcessing:
+			if startedAt := task.StartedAt(); oldestProcessing.IsZero() || startedAt.Before(oldestProcessing) {
+				oldestProcessing = startedAt
+			}
+		}
+	}
+
+	for _, group := range groups {
+		ch <- newGaugeMetric(c.tasksDesc, tasksCount[group], group.state, group.deploymentName, group.taskType)
+	}
+
+	ch <- newGaugeMetric(c.tasksOldestQueuedAgeSecondsDesc, ageSeconds(oldestQueued, now))
+	ch <- newGaugeMetric(c.tasksOldestProcessingAgeSecondsDesc, ageSeconds(oldestProcessing, now))
+}
+
+// taskType reduces a task description (e.g. "run errand smoke_tests from deployment cf")
+// to its first two words (e.g. "run errand") to keep the label cardinality low.
+func taskType(description string) string {
+	description, _, _ = strings.Cut(description, ":")
+	words := strings.Fields(strings.ToLower(description))
+	if len(words) > 2 {
+		words = words[:2]
+	}
+	return strings.Join(words, " ")
+}
+
+func ageSeconds(since time.Time, now time.Time) float64 {
+	if since.IsZero() || since.Unix() <= 0 {
+		return 0
+	}
+	return now.Sub(since).Seconds()
+}
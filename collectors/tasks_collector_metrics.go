@@ -0,0 +1,107 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type TasksCollectorMetrics struct {
+	namespace   string
+	environment string
+	boshName    string
+	boshUUID    string
+}
+
+func NewTasksCollectorMetrics(
+	namespace string,
+	environment string,
+	boshName string,
+	boshUUID string,
+) *TasksCollectorMetrics {
+	return &TasksCollectorMetrics{
+		namespace:   namespace,
+		environment: environment,
+		boshName:    boshName,
+		boshUUID:    boshUUID,
+	}
+}
+
+func (m *TasksCollectorMetrics) NewLastTasksScrapeDurationSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_tasks_scrape_duration_seconds",
+			Help:      "Duration of the last scrape of Tasks metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *TasksCollectorMetrics) NewLastTasksScrapeTimestampMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "last_tasks_scrape_timestamp",
+			Help:      "Number of seconds since 1970 since last scrape of Tasks metrics from BOSH.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *TasksCollectorMetrics) NewTasksOldestProcessingAgeSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "tasks",
+			Name:      "oldest_processing_age_seconds",
+			Help:      "Number of seconds since the oldest BOSH Director processing task started (0 when there are no processing tasks).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *TasksCollectorMetrics) NewTasksOldestQueuedAgeSecondsMetric() prometheus.Gauge {
+	return prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "tasks",
+			Name:      "oldest_queued_age_seconds",
+			Help:      "Number of seconds since the oldest BOSH Director queued task was created (0 when there are no queued tasks).",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+	)
+}
+
+func (m *TasksCollectorMetrics) NewTasksMetric() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: m.namespace,
+			Subsystem: "",
+			Name:      "tasks",
+			Help:      "Number of current and recent BOSH Director tasks.",
+			ConstLabels: prometheus.Labels{
+				"environment": m.environment,
+				"bosh_name":   m.boshName,
+				"bosh_uuid":   m.boshUUID,
+			},
+		},
+		[]string{"bosh_task_state", "bosh_deployment", "bosh_task_type"},
+	)
+}
@@ -0,0 +1,216 @@
+package collectors_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh-cli/director"
+	"github.com/cloudfoundry/bosh-cli/director/directorfakes"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
+)
+
+var _ = ginkgo.Describe("TasksCollector", func() {
+	var (
+		namespace        string
+		environment      string
+		boshName         string
+		boshUUID         string
+		boshClient       *directorfakes.FakeDirector
+		recentTasksLimit int
+		metrics          *collectors.TasksCollectorMetrics
+		tasksCollector   *collectors.TasksCollector
+
+		tasksMetric                           *prometheus.GaugeVec
+		tasksOldestQueuedAgeSecondsMetric     prometheus.Gauge
+		tasksOldestProcessingAgeSecondsMetric prometheus.Gauge
+		lastTasksScrapeTimestampMetric        prometheus.Gauge
+		lastTasksScrapeDurationSecondsMetric  prometheus.Gauge
+
+		deploymentName  = "fake-deployment-name"
+		taskDescription = "run errand smoke_tests from deployment fake-deployment-name"
+		taskType        = "run errand"
+	)
+
+	ginkgo.BeforeEach(func() {
+		namespace = testNamespace
+		environment = testEnvironment
+		boshName = testBoshName
+		boshUUID = testBoshUUID
+		boshClient = &directorfakes.FakeDirector{}
+		recentTasksLimit = 10
+		metrics = collectors.NewTasksCollectorMetrics(testNamespace, testEnvironment, testBoshName, testBoshUUID)
+
+		tasksMetric = metrics.NewTasksMetric()
+		tasksOldestQueuedAgeSecondsMetric = metrics.NewTasksOldestQueuedAgeSecondsMetric()
+		tasksOldestProcessingAgeSecondsMetric = metrics.NewTasksOldestProcessingAgeSecondsMetric()
+		lastTasksScrapeTimestampMetric = metrics.NewLastTasksScrapeTimestampMetric()
+		lastTasksScrapeDurationSecondsMetric = metrics.NewLastTasksScrapeDurationSecondsMetric()
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		tasksCollector = collectors.NewTasksCollector(namespace, environment, boshName, boshUUID, boshClient, recentTasksLimit)
+	})
+
+	ginkgo.Describe("Describe", func() {
+		var (
+			descriptions chan *prometheus.Desc
+		)
+
+		ginkgo.BeforeEach(func() {
+			descriptions = make(chan *prometheus.Desc)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			go tasksCollector.Describe(descriptions)
+		})
+
+		ginkgo.It("returns a tasks metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(tasksMetric.WithLabelValues(
+				"queued",
+				deploymentName,
+				taskType,
+			).Desc())))
+		})
+
+		ginkgo.It("returns a tasks_oldest_queued_age_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(tasksOldestQueuedAgeSecondsMetric.Desc())))
+		})
+
+		ginkgo.It("returns a tasks_oldest_processing_age_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(tasksOldestProcessingAgeSecondsMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_tasks_scrape_timestamp metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastTasksScrapeTimestampMetric.Desc())))
+		})
+
+		ginkgo.It("returns a last_tasks_scrape_duration_seconds metric description", func() {
+			gomega.Eventually(descriptions).Should(gomega.Receive(gomega.Equal(lastTasksScrapeDurationSecondsMetric.Desc())))
+		})
+	})
+
+	ginkgo.Describe("Collect", func() {
+		var (
+			queuedTask *directorfakes.FakeTask
+			doneTask   *directorfakes.FakeTask
+
+			metrics    chan prometheus.Metric
+			errMetrics chan error
+			ctx        context.Context
+		)
+
+		ginkgo.BeforeEach(func() {
+			queuedTask = &directorfakes.FakeTask{}
+			queuedTask.IDReturns(1)
+			queuedTask.StateReturns("queued")
+			queuedTask.DeploymentNameReturns(deploymentName)
+			queuedTask.DescriptionReturns(taskDescription)
+
+			doneTask = &directorfakes.FakeTask{}
+			doneTask.IDReturns(2)
+			doneTask.StateReturns("done")
+			doneTask.DeploymentNameReturns(deploymentName)
+			doneTask.DescriptionReturns(taskDescription)
+
+			boshClient.CurrentTasksReturns([]director.Task{queuedTask}, nil)
+			boshClient.RecentTasksReturns([]director.Task{queuedTask, doneTask}, nil)
+
+			tasksMetric.WithLabelValues("queued", deploymentName, taskType).Set(float64(1))
+			tasksMetric.WithLabelValues("done", deploymentName, taskType).Set(float64(1))
+
+			ctx = context.Background()
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			metrics, errMetrics = collectInBackground(ctx, tasksCollector, []deployments.DeploymentInfo{})
+		})
+
+		ginkgo.It("returns a tasks metric for queued tasks", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(tasksMetric.WithLabelValues(
+				"queued",
+				deploymentName,
+				taskType,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a tasks metric for recent tasks", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(tasksMetric.WithLabelValues(
+				"done",
+				deploymentName,
+				taskType,
+			))))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.It("returns a tasks_oldest_processing_age_seconds metric", func() {
+			gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(tasksOldestProcessingAgeSecondsMetric)))
+			gomega.Consistently(errMetrics).ShouldNot(gomega.Receive())
+		})
+
+		ginkgo.Context("when recent tasks are disabled", func() {
+			ginkgo.BeforeEach(func() {
+				recentTasksLimit = 0
+			})
+
+			ginkgo.It("does not read recent tasks", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive(matchers.PrometheusMetric(tasksMetric.WithLabelValues(
+					"queued",
+					deploymentName,
+					taskType,
+				))))
+				gomega.Expect(boshClient.RecentTasksCallCount()).To(gomega.Equal(0))
+			})
+		})
+
+		ginkgo.Context("when the BOSH Director does not answer before the scrape times out", func() {
+			var cancel context.CancelFunc
+
+			ginkgo.BeforeEach(func() {
+				ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+				hang := hangingCall()
+				boshClient.CurrentTasksStub = func(director.TasksFilter) ([]director.Task, error) {
+					hang()
+					return nil, nil
+				}
+			})
+
+			ginkgo.AfterEach(func() {
+				cancel()
+			})
+
+			ginkgo.It("returns a timeout error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive(gomega.MatchError(gomega.ContainSubstring(context.DeadlineExceeded.Error()))))
+			})
+		})
+
+		ginkgo.Context("when it fails to get the current tasks", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.CurrentTasksReturns(nil, errors.New("no tasks"))
+			})
+
+			ginkgo.It("returns only a last_tasks_scrape_timestamp & last_tasks_scrape_duration_seconds metric", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Consistently(metrics).ShouldNot(gomega.Receive())
+			})
+
+			ginkgo.It("returns an error", func() {
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(metrics).Should(gomega.Receive())
+				gomega.Eventually(errMetrics).Should(gomega.Receive())
+			})
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// File holds the settings read from the exporter configuration file. The top-level settings configure the BOSH
+// Director to monitor, and are the defaults of the Directors and probe modules listed in the file.
+type File struct {
+	Director  `yaml:",inline"`
+	Directors []Director          `yaml:"directors"`
+	Modules   map[string]Director `yaml:"modules"`
+}
+
+// LoadFile reads the exporter configuration from a YAML file. The settings are not resolved nor validated, as the
+// flags set by the user still have to take precedence over them.
+func LoadFile(filename string) (File, error) {
+	var file File
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return file, fmt.Errorf("error reading configuration file `%s`: %v", filename, err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return file, fmt.Errorf("error parsing configuration file `%s`: %v", filename, err)
+	}
+
+	return file, nil
+}
@@ -0,0 +1,169 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/config"
+)
+
+var _ = ginkgo.Describe("LoadFile", func() {
+	var (
+		err      error
+		tmpDir   string
+		filename string
+		content  string
+		file     config.File
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "config_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		filename = filepath.Join(tmpDir, "config.yml")
+
+		content = `
+environment: fake-environment
+url: https://fake-director:25555
+uaa_client_id: fake-client-id
+uaa_client_secret: fake-client-secret
+ca_cert_file: fake-ca-cert-file
+sd_filename: /tmp/fake-sd-filename.json
+filters:
+  deployments: [fake-deployment]
+  collectors: [Jobs, ServiceDiscovery]
+  cidrs: [10.0.0.0/8]
+  processes_regexp: fake-process-.*
+modules:
+  default:
+    username: fake-username
+    password: fake-password
+`
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		gomega.Expect(os.WriteFile(filename, []byte(content), 0600)).To(gomega.Succeed())
+		file, err = config.LoadFile(filename)
+	})
+
+	ginkgo.It("returns the top-level director settings", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(file.Environment).To(gomega.Equal("fake-environment"))
+		gomega.Expect(file.URL).To(gomega.Equal("https://fake-director:25555"))
+		gomega.Expect(file.UAAClientID).To(gomega.Equal("fake-client-id"))
+		gomega.Expect(file.UAAClientSecret).To(gomega.Equal("fake-client-secret"))
+		gomega.Expect(file.CACertFile).To(gomega.Equal("fake-ca-cert-file"))
+		gomega.Expect(file.SDFilename).To(gomega.Equal("/tmp/fake-sd-filename.json"))
+	})
+
+	ginkgo.It("returns the filters", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(file.Filters.Deployments).To(gomega.Equal([]string{"fake-deployment"}))
+		gomega.Expect(file.Filters.AZs).To(gomega.BeNil())
+		gomega.Expect(file.Filters.Collectors).To(gomega.Equal([]string{"Jobs", "ServiceDiscovery"}))
+		gomega.Expect(file.Filters.CIDRs).To(gomega.Equal([]string{"10.0.0.0/8"}))
+		gomega.Expect(file.Filters.ProcessesRegexp).To(gomega.Equal("fake-process-.*"))
+	})
+
+	ginkgo.It("returns the probe modules", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(file.Directors).To(gomega.BeEmpty())
+		gomega.Expect(file.Modules).To(gomega.HaveKey("default"))
+		gomega.Expect(file.Modules["default"].Username).To(gomega.Equal("fake-username"))
+	})
+
+	ginkgo.Context("when the file lists several directors", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+username: fake-username
+directors:
+  - environment: fake-environment-1
+    url: https://fake-director-1:25555
+  - environment: fake-environment-2
+    url: https://fake-director-2:25555
+`
+		})
+
+		ginkgo.It("returns the directors", func() {
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(file.Username).To(gomega.Equal("fake-username"))
+			gomega.Expect(file.Directors).To(gomega.HaveLen(2))
+			gomega.Expect(file.Directors[1].URL).To(gomega.Equal("https://fake-director-2:25555"))
+		})
+	})
+
+	ginkgo.Context("when the file does not exist", func() {
+		ginkgo.JustBeforeEach(func() {
+			file, err = config.LoadFile(filepath.Join(tmpDir, "missing.yml"))
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("error reading configuration file"))
+		})
+	})
+
+	ginkgo.Context("when the file contains an unknown setting", func() {
+		ginkgo.BeforeEach(func() {
+			content = "fake-setting: fake-value\n"
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("error parsing configuration file"))
+		})
+	})
+})
+
+var _ = ginkgo.Describe("ResolveDirectors", func() {
+	var (
+		err       error
+		directors []config.Director
+		resolved  []config.Director
+	)
+
+	ginkgo.BeforeEach(func() {
+		directors = []config.Director{
+			{Environment: "fake-environment-1", URL: "https://fake-director-1:25555"},
+			{Environment: "fake-environment-2", URL: "https://fake-director-2:25555", Username: "fake-other-username"},
+		}
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		resolved, err = config.ResolveDirectors(directors, config.Director{
+			Username:   "fake-username",
+			CACertFile: "fake-ca-cert-file",
+			SDFilename: "bosh_target_groups.json",
+		})
+	})
+
+	ginkgo.It("takes the settings not set for a director from the defaults", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(resolved[0].Username).To(gomega.Equal("fake-username"))
+		gomega.Expect(resolved[0].SDFilename).To(gomega.Equal("fake-environment-1_bosh_target_groups.json"))
+		gomega.Expect(resolved[1].Username).To(gomega.Equal("fake-other-username"))
+	})
+
+	ginkgo.It("does not modify the given directors", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(directors[0].Username).To(gomega.BeEmpty())
+		gomega.Expect(directors[0].SDFilename).To(gomega.BeEmpty())
+	})
+
+	ginkgo.Context("when there is no director", func() {
+		ginkgo.BeforeEach(func() {
+			directors = nil
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("no director configured"))
+		})
+	})
+})
@@ -0,0 +1,196 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/cloudfoundry/bosh_exporter/filters"
+)
+
+// Director holds the connection settings and filters of a BOSH Director monitored by the exporter.
+type Director struct {
+	Environment         string   `yaml:"environment"`
+	URL                 string   `yaml:"url"`
+	Username            string   `yaml:"username"`
+	Password            string   `yaml:"password"`
+	PasswordFile        string   `yaml:"password_file"`
+	UAAClientID         string   `yaml:"uaa_client_id"`
+	UAAClientSecret     string   `yaml:"uaa_client_secret"`
+	UAAClientSecretFile string   `yaml:"uaa_client_secret_file"`
+	CACertFile          string   `yaml:"ca_cert_file"`
+	AllProxy            string   `yaml:"all_proxy"`
+	SDFilename          string   `yaml:"sd_filename"`
+	Targets             []string `yaml:"targets"`
+	Filters             Filters  `yaml:"filters"`
+}
+
+// Filters holds the filters applied to the metrics of a BOSH Director.
+type Filters struct {
+	Deployments     []string `yaml:"deployments"`
+	AZs             []string `yaml:"azs"`
+	Collectors      []string `yaml:"collectors"`
+	CIDRs           []string `yaml:"cidrs"`
+	ProcessesRegexp string   `yaml:"processes_regexp"`
+}
+
+type directorsFile struct {
+	Directors []Director `yaml:"directors"`
+}
+
+// LoadDirectors reads the BOSH Directors from a YAML file. Settings not set for a Director are taken from defaults,
+// except for the Service Discovery filename which, when several Directors are configured, is prefixed with the
+// Director environment so that the Directors do not overwrite each other's targets.
+func LoadDirectors(filename string, defaults Director) ([]Director, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directors file `%s`: %v", filename, err)
+	}
+
+	var file directorsFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("error parsing directors file `%s`: %v", filename, err)
+	}
+
+	if len(file.Directors) == 0 {
+		return nil, fmt.Errorf("directors file `%s` does not contain any director", filename)
+	}
+
+	directors, err := ResolveDirectors(file.Directors, defaults)
+	if err != nil {
+		return nil, fmt.Errorf("invalid directors file `%s`: %v", filename, err)
+	}
+
+	return directors, nil
+}
+
+// ResolveDirectors takes the settings not set for a Director from defaults, as LoadDirectors does, and validates
+// the resulting Directors.
+func ResolveDirectors(directors []Director, defaults Director) ([]Director, error) {
+	resolved := slices.Clone(directors)
+	for i := range resolved {
+		director := &resolved[i]
+		if director.SDFilename == "" && len(resolved) > 1 {
+			dir, name := filepath.Split(defaults.SDFilename)
+			director.SDFilename = filepath.Join(dir, director.Environment+"_"+name)
+		}
+		director.applyDefaults(defaults)
+	}
+
+	if err := ValidateDirectors(resolved); err != nil {
+		return nil, err
+	}
+
+	return resolved, nil
+}
+
+// ValidateDirectors checks that every Director has a CA certificate and valid filters, and that the Directors can be
+// told apart in the metrics and the Service Discovery files.
+func ValidateDirectors(directors []Director) error {
+	if len(directors) == 0 {
+		return errors.New("no director configured")
+	}
+
+	environments := map[string]bool{}
+	sdFilenames := map[string]bool{}
+
+	for i, director := range directors {
+		if director.URL == "" {
+			return fmt.Errorf("director %d: url is required", i)
+		}
+		if director.Environment == "" {
+			return fmt.Errorf("director `%s`: environment is required", director.URL)
+		}
+		if environments[director.Environment] {
+			return fmt.Errorf("director `%s`: environment `%s` is used by another director", director.URL, director.Environment)
+		}
+		environments[director.Environment] = true
+
+		if len(director.Targets) > 0 {
+			return fmt.Errorf("director `%s`: targets is only supported by probe modules", director.URL)
+		}
+
+		// unlike the bosh CLI, the system root CAs are never trusted, so a BOSH Director certificate must be pinned
+		if director.CACertFile == "" {
+			return fmt.Errorf("director `%s`: ca_cert_file is required", director.URL)
+		}
+
+		if err := director.validateSecrets(); err != nil {
+			return fmt.Errorf("director `%s`: %v", director.URL, err)
+		}
+
+		if err := director.Filters.validate(); err != nil {
+			return fmt.Errorf("director `%s`: %v", director.URL, err)
+		}
+
+		if director.SDFilename != "" {
+			if sdFilenames[director.SDFilename] {
+				return fmt.Errorf("director `%s`: sd_filename `%s` is used by another director", director.URL, director.SDFilename)
+			}
+			sdFilenames[director.SDFilename] = true
+		}
+	}
+
+	return nil
+}
+
+func (f Filters) validate() error {
+	if _, err := filters.NewCollectorsFilter(f.Collectors); err != nil {
+		return err
+	}
+
+	if _, err := filters.NewCidrFilter(f.CIDRs); err != nil {
+		return err
+	}
+
+	if _, err := filters.NewRegexpFilter(f.ProcessesRegexps()); err != nil {
+		return fmt.Errorf("error processing Processes Regexp: %v", err)
+	}
+
+	return nil
+}
+
+// ProcessesRegexps returns the Service Discovery processes regexp in the form expected by filters.NewRegexpFilter.
+func (f Filters) ProcessesRegexps() []string {
+	if f.ProcessesRegexp == "" {
+		return nil
+	}
+	return []string{f.ProcessesRegexp}
+}
+
+func (d *Director) applyDefaults(defaults Director) {
+	setDefault(&d.Username, defaults.Username)
+	setSecretDefault(&d.Password, &d.PasswordFile, defaults.Password, defaults.PasswordFile)
+	setDefault(&d.UAAClientID, defaults.UAAClientID)
+	setSecretDefault(&d.UAAClientSecret, &d.UAAClientSecretFile, defaults.UAAClientSecret, defaults.UAAClientSecretFile)
+	setDefault(&d.CACertFile, defaults.CACertFile)
+	setDefault(&d.AllProxy, defaults.AllProxy)
+	setDefault(&d.SDFilename, defaults.SDFilename)
+	setDefault(&d.Filters.ProcessesRegexp, defaults.Filters.ProcessesRegexp)
+
+	if d.Filters.Deployments == nil {
+		d.Filters.Deployments = defaults.Filters.Deployments
+	}
+	if d.Filters.AZs == nil {
+		d.Filters.AZs = defaults.Filters.AZs
+	}
+	if d.Filters.Collectors == nil {
+		d.Filters.Collectors = defaults.Filters.Collectors
+	}
+	if d.Filters.CIDRs == nil {
+		d.Filters.CIDRs = defaults.Filters.CIDRs
+	}
+}
+
+func setDefault(value *string, defaultValue string) {
+	if *value == "" {
+		*value = defaultValue
+	}
+}
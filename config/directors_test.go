@@ -0,0 +1,265 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/config"
+)
+
+var _ = ginkgo.Describe("LoadDirectors", func() {
+	var (
+		err       error
+		tmpDir    string
+		filename  string
+		content   string
+		defaults  config.Director
+		directors []config.Director
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "directors_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		filename = filepath.Join(tmpDir, "directors.yml")
+
+		defaults = config.Director{
+			Username:   "fake-username",
+			Password:   "fake-password",
+			CACertFile: "fake-ca-cert-file",
+			AllProxy:   "ssh+socks5://jumpbox@fake-jumpbox:22?private-key=fake-private-key-file",
+			SDFilename: "/var/vcap/store/bosh_target_groups.json",
+			Filters: config.Filters{
+				AZs:   []string{"fake-az"},
+				CIDRs: []string{"0.0.0.0/0"},
+			},
+		}
+
+		content = `
+directors:
+  - environment: fake-environment-1
+    url: https://fake-director-1:25555
+    uaa_client_id: fake-client-id
+    uaa_client_secret: fake-client-secret
+    filters:
+      deployments: [fake-deployment]
+      azs: []
+  - environment: fake-environment-2
+    url: https://fake-director-2:25555
+    ca_cert_file: fake-other-ca-cert-file
+    sd_filename: /tmp/fake-sd-filename.json
+`
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		gomega.Expect(os.WriteFile(filename, []byte(content), 0600)).To(gomega.Succeed())
+		directors, err = config.LoadDirectors(filename, defaults)
+	})
+
+	ginkgo.It("returns the directors", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(directors).To(gomega.HaveLen(2))
+		gomega.Expect(directors[0].Environment).To(gomega.Equal("fake-environment-1"))
+		gomega.Expect(directors[0].URL).To(gomega.Equal("https://fake-director-1:25555"))
+		gomega.Expect(directors[0].UAAClientID).To(gomega.Equal("fake-client-id"))
+		gomega.Expect(directors[0].UAAClientSecret).To(gomega.Equal("fake-client-secret"))
+		gomega.Expect(directors[1].Environment).To(gomega.Equal("fake-environment-2"))
+		gomega.Expect(directors[1].URL).To(gomega.Equal("https://fake-director-2:25555"))
+	})
+
+	ginkgo.It("takes the settings not set for a director from the defaults", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(directors[0].Username).To(gomega.Equal("fake-username"))
+		gomega.Expect(directors[0].Password).To(gomega.Equal("fake-password"))
+		gomega.Expect(directors[0].CACertFile).To(gomega.Equal("fake-ca-cert-file"))
+		gomega.Expect(directors[0].AllProxy).To(gomega.Equal("ssh+socks5://jumpbox@fake-jumpbox:22?private-key=fake-private-key-file"))
+		gomega.Expect(directors[0].Filters.CIDRs).To(gomega.Equal([]string{"0.0.0.0/0"}))
+		gomega.Expect(directors[1].CACertFile).To(gomega.Equal("fake-other-ca-cert-file"))
+		gomega.Expect(directors[1].Filters.AZs).To(gomega.Equal([]string{"fake-az"}))
+	})
+
+	ginkgo.It("keeps the filters set for a director, even if empty", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(directors[0].Filters.Deployments).To(gomega.Equal([]string{"fake-deployment"}))
+		gomega.Expect(directors[0].Filters.AZs).To(gomega.BeEmpty())
+		gomega.Expect(directors[1].Filters.Deployments).To(gomega.BeNil())
+	})
+
+	ginkgo.It("prefixes the default service discovery filename with the director environment", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(directors[0].SDFilename).To(gomega.Equal("/var/vcap/store/fake-environment-1_bosh_target_groups.json"))
+		gomega.Expect(directors[1].SDFilename).To(gomega.Equal("/tmp/fake-sd-filename.json"))
+	})
+
+	ginkgo.Context("when there is a single director", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment
+    url: https://fake-director:25555
+`
+		})
+
+		ginkgo.It("uses the default service discovery filename", func() {
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(directors[0].SDFilename).To(gomega.Equal("/var/vcap/store/bosh_target_groups.json"))
+		})
+	})
+
+	ginkgo.Context("when the file does not exist", func() {
+		ginkgo.JustBeforeEach(func() {
+			directors, err = config.LoadDirectors(filepath.Join(tmpDir, "missing.yml"), defaults)
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("error reading directors file"))
+		})
+	})
+
+	ginkgo.Context("when the file contains an unknown setting", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment
+    url: https://fake-director:25555
+    unknown: fake-value
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("error parsing directors file"))
+		})
+	})
+
+	ginkgo.Context("when the file does not contain any director", func() {
+		ginkgo.BeforeEach(func() {
+			content = "directors: []\n"
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("does not contain any director"))
+		})
+	})
+
+	ginkgo.Context("when a director has no url", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("url is required"))
+		})
+	})
+
+	ginkgo.Context("when a director has no environment", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - url: https://fake-director:25555
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("environment is required"))
+		})
+	})
+
+	ginkgo.Context("when a director has no CA certificate file", func() {
+		ginkgo.BeforeEach(func() {
+			defaults.CACertFile = ""
+			content = `
+directors:
+  - environment: fake-environment
+    url: https://fake-director:25555
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("ca_cert_file is required"))
+		})
+	})
+
+	ginkgo.Context("when a director has targets", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment
+    url: https://fake-director:25555
+    targets: [https://fake-director:25555]
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("targets is only supported by probe modules"))
+		})
+	})
+
+	ginkgo.Context("when two directors have the same environment", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment
+    url: https://fake-director-1:25555
+  - environment: fake-environment
+    url: https://fake-director-2:25555
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("environment `fake-environment` is used by another director"))
+		})
+	})
+
+	ginkgo.Context("when a director has an invalid filter", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment
+    url: https://fake-director:25555
+    filters:
+      collectors: [Unknown]
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("director `https://fake-director:25555`"))
+		})
+	})
+
+	ginkgo.Context("when two directors have the same service discovery filename", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+directors:
+  - environment: fake-environment-1
+    url: https://fake-director-1:25555
+    sd_filename: /tmp/fake-sd-filename.json
+  - environment: fake-environment-2
+    url: https://fake-director-2:25555
+    sd_filename: /tmp/fake-sd-filename.json
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("sd_filename `/tmp/fake-sd-filename.json` is used by another director"))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/cloudfoundry/bosh_exporter/filters"
+)
+
+type modulesFile struct {
+	Modules map[string]Director `yaml:"modules"`
+}
+
+// LoadModules reads the probe modules from a YAML file. A module holds the credentials and filters used to probe
+// a BOSH Director, whose URL is the probe target, and the targets it may be used for. Settings not set for a module
+// are taken from defaults.
+//
+// Probes do not write Service Discovery files, so the ServiceDiscovery collector is never enabled for a module.
+func LoadModules(filename string, defaults Director) (map[string]Director, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading modules file `%s`: %v", filename, err)
+	}
+
+	var file modulesFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("error parsing modules file `%s`: %v", filename, err)
+	}
+
+	if len(file.Modules) == 0 {
+		return nil, fmt.Errorf("modules file `%s` does not contain any module", filename)
+	}
+
+	modules, err := ResolveModules(file.Modules, defaults)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modules file `%s`: %v", filename, err)
+	}
+
+	return modules, nil
+}
+
+// ResolveModules takes the settings not set for a probe module from defaults, as LoadModules does, and validates
+// the resulting modules.
+func ResolveModules(modules map[string]Director, defaults Director) (map[string]Director, error) {
+	resolved := make(map[string]Director, len(modules))
+	for name, module := range modules {
+		if module.URL != "" {
+			return nil, fmt.Errorf("module `%s`: url is set by the probe target", name)
+		}
+		if module.SDFilename != "" {
+			return nil, fmt.Errorf("module `%s`: sd_filename is not supported by probes", name)
+		}
+
+		module.applyDefaults(defaults)
+		module.SDFilename = ""
+		module.Filters.Collectors = withoutServiceDiscovery(module.Filters.Collectors)
+		if len(module.Filters.Collectors) == 0 {
+			return nil, fmt.Errorf("module `%s`: the ServiceDiscovery collector is not supported by probes", name)
+		}
+
+		// the credentials of a module must not be sent to any URL set by the caller, nor trust the system root CAs
+		if len(module.Targets) == 0 {
+			return nil, fmt.Errorf("module `%s`: targets is required", name)
+		}
+		for _, target := range module.Targets {
+			if _, err := regexp.Compile(anchored(target)); err != nil {
+				return nil, fmt.Errorf("module `%s`: invalid target `%s`: %v", name, target, err)
+			}
+		}
+		if module.CACertFile == "" {
+			return nil, fmt.Errorf("module `%s`: ca_cert_file is required", name)
+		}
+
+		if err := module.validateSecrets(); err != nil {
+			return nil, fmt.Errorf("module `%s`: %v", name, err)
+		}
+
+		if err := module.Filters.validate(); err != nil {
+			return nil, fmt.Errorf("module `%s`: %v", name, err)
+		}
+
+		resolved[name] = module
+	}
+
+	return resolved, nil
+}
+
+// AllowsTarget tells whether a probe module may be used to probe target, i.e. whether target fully matches one of
+// the module targets regular expressions.
+func (d Director) AllowsTarget(target string) bool {
+	for _, pattern := range d.Targets {
+		if matched, err := regexp.MatchString(anchored(pattern), target); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func anchored(pattern string) string {
+	return "^(?:" + pattern + ")$"
+}
+
+func withoutServiceDiscovery(collectors []string) []string {
+	if len(collectors) == 0 {
+		collectors = filters.DefaultCollectors
+	}
+
+	return slices.DeleteFunc(slices.Clone(collectors), func(collector string) bool {
+		return collector == filters.ServiceDiscoveryCollector
+	})
+}
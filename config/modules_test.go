@@ -0,0 +1,197 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/config"
+)
+
+var _ = ginkgo.Describe("LoadModules", func() {
+	var (
+		err      error
+		tmpDir   string
+		filename string
+		content  string
+		defaults config.Director
+		modules  map[string]config.Director
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "modules_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		filename = filepath.Join(tmpDir, "modules.yml")
+
+		defaults = config.Director{
+			CACertFile: "fake-ca-cert-file",
+			SDFilename: "bosh_target_groups.json",
+			Filters: config.Filters{
+				CIDRs: []string{"0.0.0.0/0"},
+			},
+		}
+
+		content = `
+modules:
+  default:
+    uaa_client_id: fake-client-id
+    uaa_client_secret: fake-client-secret
+    targets: ['https://fake-director-[0-9]+:25555']
+  jobs:
+    environment: fake-environment
+    targets: [https://fake-director:25555]
+    username: fake-username
+    password: fake-password
+    filters:
+      collectors: [Jobs, ServiceDiscovery]
+`
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		gomega.Expect(os.WriteFile(filename, []byte(content), 0600)).To(gomega.Succeed())
+		modules, err = config.LoadModules(filename, defaults)
+	})
+
+	ginkgo.It("returns the modules", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(modules).To(gomega.HaveLen(2))
+		gomega.Expect(modules["default"].UAAClientID).To(gomega.Equal("fake-client-id"))
+		gomega.Expect(modules["default"].UAAClientSecret).To(gomega.Equal("fake-client-secret"))
+		gomega.Expect(modules["jobs"].Environment).To(gomega.Equal("fake-environment"))
+		gomega.Expect(modules["jobs"].Username).To(gomega.Equal("fake-username"))
+		gomega.Expect(modules["jobs"].Password).To(gomega.Equal("fake-password"))
+	})
+
+	ginkgo.It("takes the settings not set for a module from the defaults", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(modules["default"].CACertFile).To(gomega.Equal("fake-ca-cert-file"))
+		gomega.Expect(modules["default"].Filters.CIDRs).To(gomega.Equal([]string{"0.0.0.0/0"}))
+	})
+
+	ginkgo.It("allows the targets fully matching the module targets", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(modules["default"].AllowsTarget("https://fake-director-1:25555")).To(gomega.BeTrue())
+		gomega.Expect(modules["default"].AllowsTarget("https://fake-director-1:25555.fake-attacker")).To(gomega.BeFalse())
+		gomega.Expect(modules["default"].AllowsTarget("https://fake-attacker/https://fake-director-1:25555")).To(gomega.BeFalse())
+		gomega.Expect(modules["jobs"].AllowsTarget("https://fake-director:25555")).To(gomega.BeTrue())
+		gomega.Expect(modules["jobs"].AllowsTarget("https://fake-director-1:25555")).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("does not enable the ServiceDiscovery collector", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(modules["default"].SDFilename).To(gomega.BeEmpty())
+		gomega.Expect(modules["default"].Filters.Collectors).To(gomega.Equal([]string{"Deployments", "Jobs"}))
+		gomega.Expect(modules["jobs"].Filters.Collectors).To(gomega.Equal([]string{"Jobs"}))
+	})
+
+	ginkgo.Context("when the file does not contain any module", func() {
+		ginkgo.BeforeEach(func() {
+			content = "modules: {}\n"
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("does not contain any module"))
+		})
+	})
+
+	ginkgo.Context("when a module sets the url", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+modules:
+  default:
+    url: https://fake-director:25555
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("url is set by the probe target"))
+		})
+	})
+
+	ginkgo.Context("when a module only enables the ServiceDiscovery collector", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+modules:
+  default:
+    filters:
+      collectors: [ServiceDiscovery]
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("the ServiceDiscovery collector is not supported by probes"))
+		})
+	})
+
+	ginkgo.Context("when a module has no targets", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+modules:
+  default:
+    username: fake-username
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("module `default`: targets is required"))
+		})
+	})
+
+	ginkgo.Context("when a module has an invalid target", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+modules:
+  default:
+    targets: ['https://fake-director-[:25555']
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("module `default`: invalid target"))
+		})
+	})
+
+	ginkgo.Context("when a module has no CA certificate file", func() {
+		ginkgo.BeforeEach(func() {
+			defaults.CACertFile = ""
+			content = `
+modules:
+  default:
+    targets: [https://fake-director:25555]
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("module `default`: ca_cert_file is required"))
+		})
+	})
+
+	ginkgo.Context("when a module has an invalid filter", func() {
+		ginkgo.BeforeEach(func() {
+			content = `
+modules:
+  default:
+    targets: [https://fake-director:25555]
+    filters:
+      cidrs: [fake-cidr]
+`
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("module `default`"))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReadSecretFile reads a secret from a file. The trailing newline most editors and tools write is not part of the
+// secret.
+func ReadSecretFile(filename string) (string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("error reading secret file `%s`: %v", filename, err)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// SecretFiles returns the files the secrets of the Director are read from.
+func (d Director) SecretFiles() []string {
+	var filenames []string
+	if d.PasswordFile != "" {
+		filenames = append(filenames, d.PasswordFile)
+	}
+	if d.UAAClientSecretFile != "" {
+		filenames = append(filenames, d.UAAClientSecretFile)
+	}
+	return filenames
+}
+
+// WithSecrets returns the Director with its password and UAA client secret read from their files, if set. The
+// filenames are kept, so that the secret files can be read again when they change.
+func (d Director) WithSecrets() (Director, error) {
+	if d.PasswordFile != "" {
+		password, err := ReadSecretFile(d.PasswordFile)
+		if err != nil {
+			return d, err
+		}
+		d.Password = password
+	}
+
+	if d.UAAClientSecretFile != "" {
+		uaaClientSecret, err := ReadSecretFile(d.UAAClientSecretFile)
+		if err != nil {
+			return d, err
+		}
+		d.UAAClientSecret = uaaClientSecret
+	}
+
+	return d, nil
+}
+
+func (d Director) validateSecrets() error {
+	if d.Password != "" && d.PasswordFile != "" {
+		return errors.New("password and password_file cannot be both set")
+	}
+	if d.UAAClientSecret != "" && d.UAAClientSecretFile != "" {
+		return errors.New("uaa_client_secret and uaa_client_secret_file cannot be both set")
+	}
+	return nil
+}
+
+// setSecretDefault takes a secret, and the file it is read from, from their defaults when neither is set.
+func setSecretDefault(value *string, file *string, defaultValue string, defaultFile string) {
+	if *value == "" && *file == "" {
+		*value = defaultValue
+		*file = defaultFile
+	}
+}
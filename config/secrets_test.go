@@ -0,0 +1,141 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/config"
+)
+
+var _ = ginkgo.Describe("Secrets", func() {
+	var (
+		err                 error
+		tmpDir              string
+		passwordFile        string
+		uaaClientSecretFile string
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "secrets_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+		passwordFile = filepath.Join(tmpDir, "password")
+		gomega.Expect(os.WriteFile(passwordFile, []byte("fake-password\n"), 0600)).To(gomega.Succeed())
+
+		uaaClientSecretFile = filepath.Join(tmpDir, "uaa-client-secret")
+		gomega.Expect(os.WriteFile(uaaClientSecretFile, []byte("fake-client-secret"), 0600)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	ginkgo.Describe("ReadSecretFile", func() {
+		ginkgo.It("returns the secret without its trailing newline", func() {
+			gomega.Expect(config.ReadSecretFile(passwordFile)).To(gomega.Equal("fake-password"))
+		})
+
+		ginkgo.Context("when the file does not exist", func() {
+			ginkgo.It("returns an error", func() {
+				_, err = config.ReadSecretFile(filepath.Join(tmpDir, "missing"))
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				gomega.Expect(err.Error()).To(gomega.ContainSubstring("error reading secret file"))
+			})
+		})
+	})
+
+	ginkgo.Describe("WithSecrets", func() {
+		var director config.Director
+
+		ginkgo.BeforeEach(func() {
+			director = config.Director{
+				URL:                 "https://fake-director:25555",
+				PasswordFile:        passwordFile,
+				UAAClientSecretFile: uaaClientSecretFile,
+			}
+		})
+
+		ginkgo.It("reads the secrets from their files", func() {
+			director, err = director.WithSecrets()
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(director.Password).To(gomega.Equal("fake-password"))
+			gomega.Expect(director.UAAClientSecret).To(gomega.Equal("fake-client-secret"))
+		})
+
+		ginkgo.It("keeps the secret files", func() {
+			director, err = director.WithSecrets()
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(director.SecretFiles()).To(gomega.Equal([]string{passwordFile, uaaClientSecretFile}))
+		})
+
+		ginkgo.Context("when a secret file does not exist", func() {
+			ginkgo.BeforeEach(func() {
+				director.PasswordFile = filepath.Join(tmpDir, "missing")
+			})
+
+			ginkgo.It("returns an error", func() {
+				_, err = director.WithSecrets()
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			})
+		})
+	})
+
+	ginkgo.Describe("ResolveDirectors", func() {
+		var (
+			directors []config.Director
+			defaults  config.Director
+		)
+
+		ginkgo.BeforeEach(func() {
+			defaults = config.Director{
+				Password:            "fake-default-password",
+				UAAClientSecretFile: uaaClientSecretFile,
+				CACertFile:          "fake-ca-cert-file",
+			}
+		})
+
+		ginkgo.It("takes a secret and its file from the defaults only when neither is set", func() {
+			directors, err = config.ResolveDirectors([]config.Director{
+				{
+					Environment:     "fake-environment-1",
+					URL:             "https://fake-director-1:25555",
+					PasswordFile:    passwordFile,
+					UAAClientSecret: "fake-other-client-secret",
+				},
+				{
+					Environment: "fake-environment-2",
+					URL:         "https://fake-director-2:25555",
+				},
+			}, defaults)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			gomega.Expect(directors[0].Password).To(gomega.BeEmpty())
+			gomega.Expect(directors[0].PasswordFile).To(gomega.Equal(passwordFile))
+			gomega.Expect(directors[0].UAAClientSecret).To(gomega.Equal("fake-other-client-secret"))
+			gomega.Expect(directors[0].UAAClientSecretFile).To(gomega.BeEmpty())
+
+			gomega.Expect(directors[1].Password).To(gomega.Equal("fake-default-password"))
+			gomega.Expect(directors[1].PasswordFile).To(gomega.BeEmpty())
+			gomega.Expect(directors[1].UAAClientSecret).To(gomega.BeEmpty())
+			gomega.Expect(directors[1].UAAClientSecretFile).To(gomega.Equal(uaaClientSecretFile))
+		})
+
+		ginkgo.Context("when a secret and its file are both set", func() {
+			ginkgo.It("returns an error", func() {
+				_, err = config.ResolveDirectors([]config.Director{
+					{
+						Environment:  "fake-environment",
+						URL:          "https://fake-director:25555",
+						Password:     "fake-password",
+						PasswordFile: passwordFile,
+					},
+				}, defaults)
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				gomega.Expect(err.Error()).To(gomega.ContainSubstring("password and password_file cannot be both set"))
+			})
+		})
+	})
+})
@@ -1,5 +1,9 @@
 package deployments
 
+import (
+	"time"
+)
+
 type DeploymentInfo struct {
 	Name      string
 	Instances []Instance
@@ -16,24 +20,45 @@ func (deploymentInfo *DeploymentInfo) FindReleaseByJobName(releaseJobName string
 	return Release{}, false
 }
 
+// DeploymentFetchStatus describes the last fetch of a deployment from the BOSH Director.
+type DeploymentFetchStatus struct {
+	Success  bool
+	TimedOut bool
+	Duration time.Duration
+	Errors   map[string]uint64
+}
+
 type Instance struct {
 	AgentID            string
+	VMID               string
 	Name               string
 	ID                 string
 	Index              string
 	Bootstrap          bool
 	IPs                []string
 	AZ                 string
+	State              string
+	ProcessState       string
+	Active             *bool
+	Ignore             bool
 	VMType             string
 	ResourcePool       string
 	ResurrectionPaused bool
+	VMCreatedAt        time.Time
 	Healthy            bool
 	Processes          []Process
 	Vitals             Vitals
 }
 
+// HasVM reports whether a VM is currently attached to the instance. Instances without
+// a VM (e.g. stopped with `--hard`, detached or failed to create) have no vitals nor processes.
+func (instance *Instance) HasVM() bool {
+	return instance.VMID != ""
+}
+
 type Process struct {
 	Name    string
+	State   string
 	Uptime  *uint64
 	Healthy bool
 	CPU     CPU
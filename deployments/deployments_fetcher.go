@@ -1,104 +1,396 @@
 package deployments
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cloudfoundry/bosh-cli/director"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/cloudfoundry/bosh_exporter/filters"
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
 )
 
+const (
+	InstancesFetchPhase = "instances"
+	ReleasesFetchPhase  = "releases"
+	StemcellsFetchPhase = "stemcells"
+)
+
+// FetchPhases lists the phases a deployment fetch goes through, in order.
+var FetchPhases = []string{InstancesFetchPhase, ReleasesFetchPhase, StemcellsFetchPhase}
+
 type Fetcher struct {
 	deploymentsFilter filters.DeploymentsFilter
+	workers           int
+	mutex             *sync.RWMutex
+	polling           bool
+	snapshot          []DeploymentInfo
+	refreshTimestamp  time.Time
+	refreshDuration   time.Duration
+	reachable         bool
+
+	releasesCache       map[string]Release
+	releasesCacheMutex  *sync.Mutex
+	releasesCacheHits   uint64
+	releasesCacheMisses uint64
+
+	fetchStatsMutex  *sync.Mutex
+	fetchStatus      map[string]DeploymentFetchStatus
+	fetchesInFlight  int
+	fetchesTotal     uint64
+	fetchesQueueWait time.Duration
+}
+
+// NewFetcher returns a Fetcher that reads at most workers deployments from the BOSH Director at
+// the same time. If workers is not positive, all deployments are read at the same time.
+func NewFetcher(deploymentsFilter filters.DeploymentsFilter, workers int) *Fetcher {
+	return &Fetcher{
+		deploymentsFilter:  deploymentsFilter,
+		workers:            workers,
+		mutex:              &sync.RWMutex{},
+		reachable:          true,
+		releasesCache:      map[string]Release{},
+		releasesCacheMutex: &sync.Mutex{},
+		fetchStatsMutex:    &sync.Mutex{},
+		fetchStatus:        map[string]DeploymentFetchStatus{},
+	}
+}
+
+// StartPolling refreshes the deployments snapshot in the background every interval until ctx is done.
+// From then on, Deployments returns the latest snapshot instead of walking the BOSH Director. A refresh
+// taking longer than interval is abandoned, so that a hung BOSH Director call does not stop the next ones.
+func (f *Fetcher) StartPolling(ctx context.Context, interval time.Duration) {
+	f.mutex.Lock()
+	f.polling = true
+	f.mutex.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			refreshCtx, cancel := context.WithTimeout(ctx, interval)
+			if _, err := f.refresh(refreshCtx); err != nil {
+				log.Errorf("Error refreshing deployments snapshot: %v", err)
+			}
+			cancel()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
 }
 
-func NewFetcher(deploymentsFilter filters.DeploymentsFilter) *Fetcher {
-	return &Fetcher{deploymentsFilter: deploymentsFilter}
+// Deployments returns the deployments read from the BOSH Director. If ctx is done before all of them have been read,
+// it returns the deployments read so far together with the context error.
+func (f *Fetcher) Deployments(ctx context.Context) ([]DeploymentInfo, error) {
+	f.mutex.RLock()
+	polling, snapshot, refreshTimestamp := f.polling, f.snapshot, f.refreshTimestamp
+	f.mutex.RUnlock()
+
+	if !polling {
+		return f.refresh(ctx)
+	}
+
+	if refreshTimestamp.IsZero() {
+		return nil, errors.New("deployments snapshot is not available yet")
+	}
+
+	return snapshot, nil
+}
+
+// LastRefresh returns when the deployments were last successfully fetched from the BOSH Director and how long it took.
+func (f *Fetcher) LastRefresh() (time.Time, time.Duration) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.refreshTimestamp, f.refreshDuration
+}
+
+// Reachable tells whether the BOSH Director answered the last time the deployments were fetched, i.e. whether the
+// deployments could be listed and at least one of them could be read. It is true until the first fetch.
+func (f *Fetcher) Reachable() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.reachable
+}
+
+// ReleasesCacheStats returns how many release lookups were served from the releases cache and how many had to be
+// read from the BOSH Director.
+func (f *Fetcher) ReleasesCacheStats() (hits uint64, misses uint64) {
+	f.releasesCacheMutex.Lock()
+	defer f.releasesCacheMutex.Unlock()
+
+	return f.releasesCacheHits, f.releasesCacheMisses
 }
 
-func (f *Fetcher) Deployments() ([]DeploymentInfo, error) {
+// FetchStats returns the number of deployments being read from the BOSH Director right now, the total number of
+// deployments read, and the total time they have been waiting for a free worker.
+func (f *Fetcher) FetchStats() (inFlight int, total uint64, queueWait time.Duration) {
+	f.fetchStatsMutex.Lock()
+	defer f.fetchStatsMutex.Unlock()
+
+	return f.fetchesInFlight, f.fetchesTotal, f.fetchesQueueWait
+}
+
+// DeploymentsFetchStatus returns the outcome of the last fetch of every deployment.
+func (f *Fetcher) DeploymentsFetchStatus() map[string]DeploymentFetchStatus {
+	f.fetchStatsMutex.Lock()
+	defer f.fetchStatsMutex.Unlock()
+
+	deploymentsFetchStatus := make(map[string]DeploymentFetchStatus, len(f.fetchStatus))
+	for deploymentName, status := range f.fetchStatus {
+		phaseErrors := make(map[string]uint64, len(status.Errors))
+		for phase, total := range status.Errors {
+			phaseErrors[phase] = total
+		}
+		status.Errors = phaseErrors
+		deploymentsFetchStatus[deploymentName] = status
+	}
+
+	return deploymentsFetchStatus
+}
+
+func (f *Fetcher) refresh(ctx context.Context) ([]DeploymentInfo, error) {
+	var begun = time.Now()
+
+	deploymentsInfo, err := f.fetchDeployments(ctx)
+	if err != nil {
+		return deploymentsInfo, err
+	}
+
+	f.mutex.Lock()
+	f.snapshot = deploymentsInfo
+	f.refreshTimestamp = time.Now()
+	f.refreshDuration = time.Since(begun)
+	f.mutex.Unlock()
+
+	return deploymentsInfo, nil
+}
+
+func (f *Fetcher) fetchDeployments(ctx context.Context) ([]DeploymentInfo, error) {
 	var deploymentsInfo []DeploymentInfo
 	var mutex = &sync.Mutex{}
 	var wg = &sync.WaitGroup{}
 
-	deployments, err := f.deploymentsFilter.GetDeployments()
+	deployments, err := f.deploymentsFilter.GetDeployments(ctx)
 	if err != nil {
+		f.setReachable(false)
 		return deploymentsInfo, err
 	}
 
-	for _, deployment := range deployments {
+	f.forgetDeletedDeployments(deployments)
+
+	queue := make(chan director.Deployment, len(deployments))
+	for _, deployment := range f.slowestFirst(deployments) {
+		queue <- deployment
+	}
+	close(queue)
+	queuedAt := time.Now()
+
+	workers := f.workers
+	if workers <= 0 || workers > len(deployments) {
+		workers = len(deployments)
+	}
+
+	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go func(deployment director.Deployment) {
+		go func() {
 			defer wg.Done()
-			deploymentInfo, err := f.fetchDeploymentInfo(deployment)
-			if err != nil {
-				log.Error(err)
-				return
+			for deployment := range queue {
+				if ctx.Err() != nil {
+					f.fetchTimedOut(deployment.Name())
+					continue
+				}
+
+				f.fetchStarted(time.Since(queuedAt))
+				begun := time.Now()
+				deploymentInfo, failedPhase, err := f.fetchDeploymentInfo(ctx, deployment)
+				f.fetchFinished(deployment.Name(), time.Since(begun), failedPhase, ctx.Err() != nil)
+				if err != nil {
+					log.Error(err)
+					continue
+				}
+
+				mutex.Lock()
+				deploymentsInfo = append(deploymentsInfo, *deploymentInfo)
+				mutex.Unlock()
 			}
-
-			mutex.Lock()
-			deploymentsInfo = append(deploymentsInfo, *deploymentInfo)
-			mutex.Unlock()
-		}(deployment)
+		}()
 	}
 	wg.Wait()
 
+	f.setReachable(len(deployments) == 0 || len(deploymentsInfo) > 0)
+
+	if err := ctx.Err(); err != nil {
+		return deploymentsInfo, fmt.Errorf("timed out reading deployments, only %d out of %d were read: %w", len(deploymentsInfo), len(deployments), err)
+	}
+
 	return deploymentsInfo, nil
 }
 
-func (f *Fetcher) fetchDeploymentInfo(deployment director.Deployment) (*DeploymentInfo, error) {
+func (f *Fetcher) setReachable(reachable bool) {
+	f.mutex.Lock()
+	f.reachable = reachable
+	f.mutex.Unlock()
+}
+
+// slowestFirst orders the deployments by how long they took to read the last time, so the slowest ones do not
+// end up waiting for a free worker at the end of the fetch. Deployments never read before go first.
+func (f *Fetcher) slowestFirst(deployments []director.Deployment) []director.Deployment {
+	durations := make([]time.Duration, len(deployments))
+	known := make([]bool, len(deployments))
+
+	f.fetchStatsMutex.Lock()
+	for i, deployment := range deployments {
+		var status DeploymentFetchStatus
+		status, known[i] = f.fetchStatus[deployment.Name()]
+		durations[i] = status.Duration
+	}
+	f.fetchStatsMutex.Unlock()
+
+	order := make([]int, len(deployments))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if known[order[i]] != known[order[j]] {
+			return !known[order[i]]
+		}
+		return durations[order[i]] > durations[order[j]]
+	})
+
+	sorted := make([]director.Deployment, len(deployments))
+	for i, index := range order {
+		sorted[i] = deployments[index]
+	}
+	return sorted
+}
+
+func (f *Fetcher) fetchStarted(queueWait time.Duration) {
+	f.fetchStatsMutex.Lock()
+	defer f.fetchStatsMutex.Unlock()
+
+	f.fetchesInFlight++
+	f.fetchesTotal++
+	f.fetchesQueueWait += queueWait
+}
+
+// fetchFinished records the outcome of a deployment fetch. failedPhase is empty if the fetch succeeded.
+func (f *Fetcher) fetchFinished(deploymentName string, duration time.Duration, failedPhase string, timedOut bool) {
+	f.fetchStatsMutex.Lock()
+	defer f.fetchStatsMutex.Unlock()
+
+	f.fetchesInFlight--
+
+	status, ok := f.fetchStatus[deploymentName]
+	if !ok {
+		status.Errors = map[string]uint64{}
+	}
+	status.Success = failedPhase == ""
+	status.TimedOut = timedOut && !status.Success
+	status.Duration = duration
+	if !status.Success {
+		status.Errors[failedPhase]++
+	}
+	f.fetchStatus[deploymentName] = status
+}
+
+// fetchTimedOut records that the deployment was not read because the fetch ran out of time before reaching it.
+// The duration of its previous fetch is kept so it is still ordered by it on the next fetch.
+func (f *Fetcher) fetchTimedOut(deploymentName string) {
+	f.fetchStatsMutex.Lock()
+	defer f.fetchStatsMutex.Unlock()
+
+	status, ok := f.fetchStatus[deploymentName]
+	if !ok {
+		status.Errors = map[string]uint64{}
+	}
+	status.Success = false
+	status.TimedOut = true
+	f.fetchStatus[deploymentName] = status
+}
+
+// forgetDeletedDeployments drops the fetch status of deployments that no longer exist, so they stop being reported.
+func (f *Fetcher) forgetDeletedDeployments(deployments []director.Deployment) {
+	deploymentNames := make(map[string]bool, len(deployments))
+	for _, deployment := range deployments {
+		deploymentNames[deployment.Name()] = true
+	}
+
+	f.fetchStatsMutex.Lock()
+	defer f.fetchStatsMutex.Unlock()
+
+	for deploymentName := range f.fetchStatus {
+		if !deploymentNames[deploymentName] {
+			delete(f.fetchStatus, deploymentName)
+		}
+	}
+}
+
+// fetchDeploymentInfo reads a deployment from the BOSH Director. On error, it also returns the fetch phase that failed.
+func (f *Fetcher) fetchDeploymentInfo(ctx context.Context, deployment director.Deployment) (*DeploymentInfo, string, error) {
 	deploymentInfo := &DeploymentInfo{
 		Name: deployment.Name(),
 	}
 
-	instances, err := f.fetchDeploymentInstances(deployment)
+	instances, err := f.fetchDeploymentInstances(ctx, deployment)
 	if err != nil {
-		return deploymentInfo, err
+		return deploymentInfo, InstancesFetchPhase, err
 	}
 	deploymentInfo.Instances = instances
 
-	releases, err := f.fetchDeploymentReleases(deployment)
+	releases, err := f.fetchDeploymentReleases(ctx, deployment)
 	if err != nil {
-		return deploymentInfo, err
+		return deploymentInfo, ReleasesFetchPhase, err
 	}
 	deploymentInfo.Releases = releases
 
-	stemcells, err := f.fetchDeploymentStemcells(deployment)
+	stemcells, err := f.fetchDeploymentStemcells(ctx, deployment)
 	if err != nil {
-		return deploymentInfo, err
+		return deploymentInfo, StemcellsFetchPhase, err
 	}
 	deploymentInfo.Stemcells = stemcells
 
-	return deploymentInfo, nil
+	return deploymentInfo, "", nil
 }
 
-func (f *Fetcher) fetchDeploymentInstances(deployment director.Deployment) ([]Instance, error) {
+func (f *Fetcher) fetchDeploymentInstances(ctx context.Context, deployment director.Deployment) ([]Instance, error) {
 	var deploymentInstances []Instance
 
 	log.Debugf("Reading Instances for deployment `%s`:", deployment.Name())
-	instances, err := deployment.InstanceInfos()
+	instances, err := ctxcall.Do(ctx, deployment.InstanceInfos)
 	if err != nil {
 		return deploymentInstances, fmt.Errorf("error while reading Instances for deployment `%s`: %v", deployment.Name(), err)
 	}
 
 	for _, instance := range instances {
-		if instance.VMID == "" {
-			continue
-		}
-
 		deploymentInstance := Instance{
 			AgentID:            instance.AgentID,
+			VMID:               instance.VMID,
 			Name:               instance.JobName,
 			ID:                 instance.ID,
 			Bootstrap:          instance.Bootstrap,
 			IPs:                instance.IPs,
 			AZ:                 instance.AZ,
+			State:              instance.State,
+			ProcessState:       instance.ProcessState,
+			Active:             instance.Active,
+			Ignore:             instance.Ignore,
 			VMType:             instance.VMType,
 			ResourcePool:       instance.ResourcePool,
 			ResurrectionPaused: instance.ResurrectionPaused,
+			VMCreatedAt:        instance.VMCreatedAt,
 			Healthy:            instance.IsRunning(),
 			Vitals: Vitals{
 				CPU: CPU{
@@ -139,6 +431,7 @@ func (f *Fetcher) fetchDeploymentInstances(deployment director.Deployment) ([]In
 		for _, process := range instance.Processes {
 			deploymentProcess := Process{
 				Name:    process.Name,
+				State:   process.State,
 				Uptime:  process.Uptime.Seconds,
 				Healthy: process.IsRunning(),
 				CPU: CPU{
@@ -159,38 +452,68 @@ func (f *Fetcher) fetchDeploymentInstances(deployment director.Deployment) ([]In
 	return deploymentInstances, nil
 }
 
-func (f *Fetcher) fetchDeploymentReleases(deployment director.Deployment) ([]Release, error) {
+func (f *Fetcher) fetchDeploymentReleases(ctx context.Context, deployment director.Deployment) ([]Release, error) {
 	var deploymentReleases []Release
 
 	log.Debugf("Reading Releases for deployment `%s`:", deployment.Name())
-	releases, err := deployment.Releases()
+	releases, err := ctxcall.Do(ctx, deployment.Releases)
 	if err != nil {
 		return deploymentReleases, fmt.Errorf("error while reading Releases for deployment `%s`: %v", deployment.Name(), err)
 	}
 
 	for _, release := range releases {
-		jobNames, err := f.fetchReleaseJobs(release, deployment.Name())
-		if err != nil {
-			return deploymentReleases, err
-		}
-		packageNames, err := f.fetchReleasePackages(release, deployment.Name())
+		deploymentRelease, err := f.fetchRelease(ctx, release, deployment.Name())
 		if err != nil {
 			return deploymentReleases, err
 		}
-		deploymentRelease := Release{
-			Name:         release.Name(),
-			Version:      release.Version().AsString(),
-			JobNames:     jobNames,
-			PackageNames: packageNames,
-		}
 		deploymentReleases = append(deploymentReleases, deploymentRelease)
 	}
 
 	return deploymentReleases, nil
 }
 
-func (f *Fetcher) fetchReleaseJobs(release director.Release, deploymentName string) ([]string, error) {
-	jobs, err := release.Jobs()
+// fetchRelease returns the release jobs and packages from the releases cache, as they never change for a given
+// release name and version, and only reads them from the BOSH Director the first time the release is seen.
+func (f *Fetcher) fetchRelease(ctx context.Context, release director.Release, deploymentName string) (Release, error) {
+	releaseKey := release.Name() + ":" + release.Version().AsString()
+
+	f.releasesCacheMutex.Lock()
+	deploymentRelease, found := f.releasesCache[releaseKey]
+	if found {
+		f.releasesCacheHits++
+	} else {
+		f.releasesCacheMisses++
+	}
+	f.releasesCacheMutex.Unlock()
+
+	if found {
+		return deploymentRelease, nil
+	}
+
+	jobNames, err := f.fetchReleaseJobs(ctx, release, deploymentName)
+	if err != nil {
+		return deploymentRelease, err
+	}
+	packageNames, err := f.fetchReleasePackages(ctx, release, deploymentName)
+	if err != nil {
+		return deploymentRelease, err
+	}
+	deploymentRelease = Release{
+		Name:         release.Name(),
+		Version:      release.Version().AsString(),
+		JobNames:     jobNames,
+		PackageNames: packageNames,
+	}
+
+	f.releasesCacheMutex.Lock()
+	f.releasesCache[releaseKey] = deploymentRelease
+	f.releasesCacheMutex.Unlock()
+
+	return deploymentRelease, nil
+}
+
+func (f *Fetcher) fetchReleaseJobs(ctx context.Context, release director.Release, deploymentName string) ([]string, error) {
+	jobs, err := ctxcall.Do(ctx, release.Jobs)
 	var jobNames []string
 	if err != nil {
 		return jobNames, fmt.Errorf("error while reading release `%s` (deployment: %s) jobs: %v", release.Name(), deploymentName, err)
@@ -201,8 +524,8 @@ func (f *Fetcher) fetchReleaseJobs(release director.Release, deploymentName stri
 	return jobNames, nil
 }
 
-func (f *Fetcher) fetchReleasePackages(release director.Release, deploymentName string) ([]string, error) {
-	packages, err := release.Packages()
+func (f *Fetcher) fetchReleasePackages(ctx context.Context, release director.Release, deploymentName string) ([]string, error) {
+	packages, err := ctxcall.Do(ctx, release.Packages)
 	var packageNames []string
 	if err != nil {
 		return packageNames, fmt.Errorf("error while reading release `%s` (deployment: %s) packages: %v", release.Name(), deploymentName, err)
@@ -213,11 +536,11 @@ func (f *Fetcher) fetchReleasePackages(release director.Release, deploymentName
 	return packageNames, nil
 }
 
-func (f *Fetcher) fetchDeploymentStemcells(deployment director.Deployment) ([]Stemcell, error) {
+func (f *Fetcher) fetchDeploymentStemcells(ctx context.Context, deployment director.Deployment) ([]Stemcell, error) {
 	var deploymentStemcells []Stemcell
 
 	log.Debugf("Reading Stemcells for deployment `%s`:", deployment.Name())
-	stemcells, err := deployment.Stemcells()
+	stemcells, err := ctxcall.Do(ctx, deployment.Stemcells)
 	if err != nil {
 		return deploymentStemcells, fmt.Errorf("error while reading Stemcells for deployment `%s`: %v", deployment.Name(), err)
 	}
@@ -1,8 +1,11 @@
 package deployments_test
 
 import (
+	"context"
 	"errors"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
@@ -22,17 +25,26 @@ var _ = ginkgo.Describe("Fetcher", func() {
 		boshDeployments    []string
 		boshClient         *directorfakes.FakeDirector
 		deploymentsFilter  *filters.DeploymentsFilter
+		fetchWorkers       int
 		deploymentsFetcher *deployments.Fetcher
+		ctx                context.Context
+		cancel             context.CancelFunc
 	)
 
 	ginkgo.BeforeEach(func() {
 		boshDeployments = []string{}
 		boshClient = &directorfakes.FakeDirector{}
+		fetchWorkers = 10
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	ginkgo.AfterEach(func() {
+		cancel()
 	})
 
 	ginkgo.JustBeforeEach(func() {
 		deploymentsFilter = filters.NewDeploymentsFilter(boshDeployments, boshClient)
-		deploymentsFetcher = deployments.NewFetcher(*deploymentsFilter)
+		deploymentsFetcher = deployments.NewFetcher(*deploymentsFilter, fetchWorkers)
 	})
 
 	ginkgo.Describe("Deployments", func() {
@@ -45,10 +57,13 @@ var _ = ginkgo.Describe("Fetcher", func() {
 			jobBootstrap                  = true
 			jobIP                         = "1.2.3.4"
 			jobAZ                         = "fake-job-az"
+			jobState                      = "started"
+			jobActive                     = true
 			jobVMType                     = "fake-job-vm-type"
 			jobResourcePool               = "fake-job-resource-pool"
 			jobResurrectionPause          = true
 			jobVMID                       = "fake-job-vmid"
+			jobVMCreatedAt                = time.Date(2026, time.January, 2, 3, 4, 5, 0, time.UTC)
 			processState                  = "running"
 			jobUptimeSeconds              = uint64(3600)
 			jobLoadAvg01                  = float64(0.01)
@@ -86,7 +101,7 @@ var _ = ginkgo.Describe("Fetcher", func() {
 			processes  []director.VMInfoProcess
 			vitals     director.VMInfoVitals
 			instances  []director.VMInfo
-			release    director.Release
+			release    *directorfakes.FakeRelease
 			releases   []director.Release
 			stemcell   director.Stemcell
 			stemcells  []director.Stemcell
@@ -154,12 +169,16 @@ var _ = ginkgo.Describe("Fetcher", func() {
 					Index:              &jobIndex,
 					Bootstrap:          jobBootstrap,
 					ProcessState:       processState,
+					Active:             &jobActive,
+					Ignore:             true,
 					IPs:                []string{jobIP},
 					AZ:                 jobAZ,
+					State:              jobState,
 					VMType:             jobVMType,
 					ResourcePool:       jobResourcePool,
 					ResurrectionPaused: jobResurrectionPause,
 					VMID:               jobVMID,
+					VMCreatedAt:        jobVMCreatedAt,
 					Vitals:             vitals,
 					Processes:          processes,
 				},
@@ -200,19 +219,26 @@ var _ = ginkgo.Describe("Fetcher", func() {
 					Instances: []deployments.Instance{
 						{
 							AgentID:            agentID,
+							VMID:               jobVMID,
 							Name:               jobName,
 							ID:                 jobID,
 							Index:              strconv.Itoa(jobIndex),
 							Bootstrap:          jobBootstrap,
 							IPs:                []string{jobIP},
 							AZ:                 jobAZ,
+							State:              jobState,
+							ProcessState:       processState,
+							Active:             &jobActive,
+							Ignore:             true,
 							VMType:             jobVMType,
 							ResourcePool:       jobResourcePool,
 							ResurrectionPaused: jobResurrectionPause,
+							VMCreatedAt:        jobVMCreatedAt,
 							Healthy:            true,
 							Processes: []deployments.Process{
 								{
 									Name:    jobProcessName,
+									State:   jobProcessState,
 									Uptime:  &jobProcessUptimeSeconds,
 									Healthy: true,
 									CPU:     deployments.CPU{Total: &jobProcessCPUTotal},
@@ -268,7 +294,7 @@ var _ = ginkgo.Describe("Fetcher", func() {
 		})
 
 		ginkgo.JustBeforeEach(func() {
-			deploymentsInfo, err = deploymentsFetcher.Deployments()
+			deploymentsInfo, err = deploymentsFetcher.Deployments(ctx)
 		})
 
 		ginkgo.It("returns the deployments", func() {
@@ -276,20 +302,167 @@ var _ = ginkgo.Describe("Fetcher", func() {
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
 
+		ginkgo.Context("when the deployments are fetched again", func() {
+			ginkgo.JustBeforeEach(func() {
+				deploymentsInfo, err = deploymentsFetcher.Deployments(ctx)
+			})
+
+			ginkgo.It("returns the deployments", func() {
+				gomega.Expect(deploymentsInfo).To(gomega.Equal(expectedDeploymentsInfo))
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			})
+
+			ginkgo.It("reads the release jobs and packages only once", func() {
+				gomega.Expect(release.JobsCallCount()).To(gomega.Equal(1))
+				gomega.Expect(release.PackagesCallCount()).To(gomega.Equal(1))
+			})
+
+			ginkgo.It("reports a releases cache miss and a releases cache hit", func() {
+				hits, misses := deploymentsFetcher.ReleasesCacheStats()
+				gomega.Expect(hits).To(gomega.Equal(uint64(1)))
+				gomega.Expect(misses).To(gomega.Equal(uint64(1)))
+			})
+
+			ginkgo.Context("and it failed to get the release jobs the first time", func() {
+				ginkgo.BeforeEach(func() {
+					release.JobsReturnsOnCall(0, nil, errors.New("no jobs"))
+					release.JobsReturnsOnCall(1, []director.Job{{Name: releaseJob1Name}, {Name: releaseJob2Name}}, nil)
+				})
+
+				ginkgo.It("reads the release jobs again", func() {
+					gomega.Expect(deploymentsInfo).To(gomega.Equal(expectedDeploymentsInfo))
+					gomega.Expect(release.JobsCallCount()).To(gomega.Equal(2))
+				})
+			})
+		})
+
+		ginkgo.Context("when there are more deployments than workers", func() {
+			var (
+				fetchOrder      []string
+				fetching        int
+				maxFetching     int
+				fetchOrderMutex = &sync.Mutex{}
+			)
+
+			newDeployment := func(name string, delay time.Duration) director.Deployment {
+				return &directorfakes.FakeDeployment{
+					NameStub: func() string { return name },
+					InstanceInfosStub: func() ([]director.VMInfo, error) {
+						fetchOrderMutex.Lock()
+						fetchOrder = append(fetchOrder, name)
+						fetching++
+						maxFetching = max(maxFetching, fetching)
+						fetchOrderMutex.Unlock()
+
+						time.Sleep(delay)
+
+						fetchOrderMutex.Lock()
+						fetching--
+						fetchOrderMutex.Unlock()
+						return nil, nil
+					},
+				}
+			}
+
+			ginkgo.BeforeEach(func() {
+				fetchWorkers = 1
+				fetchOrder = nil
+				fetching = 0
+				maxFetching = 0
+				boshClient.DeploymentsReturns([]director.Deployment{
+					newDeployment("fake-fast-deployment", 0),
+					newDeployment("fake-slow-deployment", 50*time.Millisecond),
+				}, nil)
+			})
+
+			ginkgo.JustBeforeEach(func() {
+				deploymentsInfo, err = deploymentsFetcher.Deployments(ctx)
+			})
+
+			ginkgo.It("does not fetch more deployments at the same time than workers", func() {
+				gomega.Expect(deploymentsInfo).To(gomega.HaveLen(2))
+				gomega.Expect(maxFetching).To(gomega.Equal(1))
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			})
+
+			ginkgo.It("fetches the slowest deployments first", func() {
+				gomega.Expect(fetchOrder).To(gomega.Equal([]string{
+					"fake-fast-deployment",
+					"fake-slow-deployment",
+					"fake-slow-deployment",
+					"fake-fast-deployment",
+				}))
+			})
+
+			ginkgo.It("reports the deployments fetches", func() {
+				inFlight, total, queueWait := deploymentsFetcher.FetchStats()
+				gomega.Expect(inFlight).To(gomega.Equal(0))
+				gomega.Expect(total).To(gomega.Equal(uint64(4)))
+				gomega.Expect(queueWait).To(gomega.BeNumerically(">=", 50*time.Millisecond))
+			})
+		})
+
+		ginkgo.It("reports the BOSH Director as reachable", func() {
+			gomega.Expect(deploymentsFetcher.Reachable()).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("reports the deployment fetch as successful", func() {
+			status := deploymentsFetcher.DeploymentsFetchStatus()
+			gomega.Expect(status).To(gomega.HaveKey(deploymentName))
+			gomega.Expect(status[deploymentName].Success).To(gomega.BeTrue())
+			gomega.Expect(status[deploymentName].Errors).To(gomega.BeEmpty())
+		})
+
+		ginkgo.Context("when the context expires before all deployments are fetched", func() {
+			ginkgo.BeforeEach(func() {
+				fetchWorkers = 1
+				cancel()
+				ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+
+				boshClient.DeploymentsReturns([]director.Deployment{
+					&directorfakes.FakeDeployment{
+						NameStub: func() string { return "fake-fast-deployment" },
+					},
+					&directorfakes.FakeDeployment{
+						NameStub: func() string { return "fake-slow-deployment" },
+						InstanceInfosStub: func() ([]director.VMInfo, error) {
+							time.Sleep(100 * time.Millisecond)
+							return nil, nil
+						},
+					},
+					&directorfakes.FakeDeployment{
+						NameStub: func() string { return "fake-other-deployment" },
+					},
+				}, nil)
+			})
+
+			ginkgo.It("returns the deployments fetched so far", func() {
+				gomega.Expect(deploymentsInfo).To(gomega.HaveLen(1))
+				gomega.Expect(deploymentsInfo[0].Name).To(gomega.Equal("fake-fast-deployment"))
+				gomega.Expect(err).To(gomega.MatchError(context.DeadlineExceeded))
+			})
+
+			ginkgo.It("reports the deployments that were not fetched in time as timed out", func() {
+				status := deploymentsFetcher.DeploymentsFetchStatus()
+				gomega.Expect(status["fake-fast-deployment"].Success).To(gomega.BeTrue())
+				gomega.Expect(status["fake-fast-deployment"].TimedOut).To(gomega.BeFalse())
+				gomega.Expect(status["fake-slow-deployment"].TimedOut).To(gomega.BeTrue())
+				gomega.Expect(status["fake-slow-deployment"].Errors).To(gomega.Equal(map[string]uint64{deployments.InstancesFetchPhase: 1}))
+				gomega.Expect(status["fake-other-deployment"].TimedOut).To(gomega.BeTrue())
+				gomega.Expect(status["fake-other-deployment"].Errors).To(gomega.BeEmpty())
+			})
+		})
+
 		ginkgo.Context("when instance has no VMID", func() {
 			ginkgo.BeforeEach(func() {
 				instances[0].VMID = ""
-				deployment = &directorfakes.FakeDeployment{
-					NameStub:      func() string { return deploymentName },
-					ReleasesStub:  func() ([]director.Release, error) { return releases, nil },
-					StemcellsStub: func() ([]director.Stemcell, error) { return stemcells, nil },
-				}
-				depls = []director.Deployment{deployment}
-				boshClient.DeploymentsReturns(depls, nil)
+				instances[0].State = "stopped"
 			})
 
-			ginkgo.It("does not return the instance", func() {
-				gomega.Expect(deploymentsInfo[0].Instances).To(gomega.BeEmpty())
+			ginkgo.It("returns the instance without a VM", func() {
+				gomega.Expect(deploymentsInfo[0].Instances).To(gomega.HaveLen(1))
+				gomega.Expect(deploymentsInfo[0].Instances[0].HasVM()).To(gomega.BeFalse())
+				gomega.Expect(deploymentsInfo[0].Instances[0].State).To(gomega.Equal("stopped"))
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 			})
 		})
@@ -303,6 +476,10 @@ var _ = ginkgo.Describe("Fetcher", func() {
 				gomega.Expect(deploymentsInfo).To(gomega.BeEmpty())
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 			})
+
+			ginkgo.It("reports the BOSH Director as reachable", func() {
+				gomega.Expect(deploymentsFetcher.Reachable()).To(gomega.BeTrue())
+			})
 		})
 
 		ginkgo.Context("when it fails to get the deployment", func() {
@@ -314,6 +491,22 @@ var _ = ginkgo.Describe("Fetcher", func() {
 				gomega.Expect(deploymentsInfo).To(gomega.BeEmpty())
 				gomega.Expect(err).To(gomega.HaveOccurred())
 			})
+
+			ginkgo.It("reports the BOSH Director as unreachable", func() {
+				gomega.Expect(deploymentsFetcher.Reachable()).To(gomega.BeFalse())
+			})
+
+			ginkgo.Context("and it gets them again", func() {
+				ginkgo.JustBeforeEach(func() {
+					boshClient.DeploymentsReturns(depls, nil)
+					deploymentsInfo, err = deploymentsFetcher.Deployments(ctx)
+				})
+
+				ginkgo.It("reports the BOSH Director as reachable again", func() {
+					gomega.Expect(err).ToNot(gomega.HaveOccurred())
+					gomega.Expect(deploymentsFetcher.Reachable()).To(gomega.BeTrue())
+				})
+			})
 		})
 
 		ginkgo.Context("when there are no instances", func() {
@@ -347,6 +540,16 @@ var _ = ginkgo.Describe("Fetcher", func() {
 				gomega.Expect(deploymentsInfo).To(gomega.BeEmpty())
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 			})
+
+			ginkgo.It("reports a failed deployment fetch in the instances phase", func() {
+				status := deploymentsFetcher.DeploymentsFetchStatus()
+				gomega.Expect(status[deploymentName].Success).To(gomega.BeFalse())
+				gomega.Expect(status[deploymentName].Errors).To(gomega.Equal(map[string]uint64{deployments.InstancesFetchPhase: 1}))
+			})
+
+			ginkgo.It("reports the BOSH Director as unreachable", func() {
+				gomega.Expect(deploymentsFetcher.Reachable()).To(gomega.BeFalse())
+			})
 		})
 
 		ginkgo.Context("when there are no releases", func() {
@@ -380,6 +583,12 @@ var _ = ginkgo.Describe("Fetcher", func() {
 				gomega.Expect(deploymentsInfo).To(gomega.BeEmpty())
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 			})
+
+			ginkgo.It("reports a failed deployment fetch in the releases phase", func() {
+				status := deploymentsFetcher.DeploymentsFetchStatus()
+				gomega.Expect(status[deploymentName].Success).To(gomega.BeFalse())
+				gomega.Expect(status[deploymentName].Errors).To(gomega.Equal(map[string]uint64{deployments.ReleasesFetchPhase: 1}))
+			})
 		})
 
 		ginkgo.Context("when there are no stemcells", func() {
@@ -413,6 +622,90 @@ var _ = ginkgo.Describe("Fetcher", func() {
 				gomega.Expect(deploymentsInfo).To(gomega.BeEmpty())
 				gomega.Expect(err).ToNot(gomega.HaveOccurred())
 			})
+
+			ginkgo.It("reports a failed deployment fetch in the stemcells phase", func() {
+				status := deploymentsFetcher.DeploymentsFetchStatus()
+				gomega.Expect(status[deploymentName].Success).To(gomega.BeFalse())
+				gomega.Expect(status[deploymentName].Errors).To(gomega.Equal(map[string]uint64{deployments.StemcellsFetchPhase: 1}))
+			})
+		})
+	})
+
+	ginkgo.Describe("StartPolling", func() {
+		var (
+			deploymentsInfo []deployments.DeploymentInfo
+			interval        time.Duration
+		)
+
+		ginkgo.BeforeEach(func() {
+			interval = time.Hour
+			boshClient.DeploymentsReturns([]director.Deployment{}, nil)
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			deploymentsFetcher.StartPolling(ctx, interval)
+		})
+
+		ginkgo.It("refreshes the deployments snapshot in the background", func() {
+			gomega.Eventually(func() time.Time {
+				refreshTimestamp, _ := deploymentsFetcher.LastRefresh()
+				return refreshTimestamp
+			}).ShouldNot(gomega.BeZero())
+			gomega.Expect(boshClient.DeploymentsCallCount()).To(gomega.Equal(1))
+		})
+
+		ginkgo.It("returns the deployments snapshot without fetching the deployments again", func() {
+			gomega.Eventually(func() error {
+				deploymentsInfo, err = deploymentsFetcher.Deployments(ctx)
+				return err
+			}).ShouldNot(gomega.HaveOccurred())
+			gomega.Expect(deploymentsInfo).To(gomega.BeEmpty())
+
+			_, err = deploymentsFetcher.Deployments(ctx)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(boshClient.DeploymentsCallCount()).To(gomega.Equal(1))
+		})
+
+		ginkgo.Context("when it fails to get the deployments", func() {
+			ginkgo.BeforeEach(func() {
+				boshClient.DeploymentsReturns([]director.Deployment{}, errors.New("no deployments"))
+			})
+
+			ginkgo.It("returns an error until a snapshot is available", func() {
+				gomega.Eventually(boshClient.DeploymentsCallCount).Should(gomega.Equal(1))
+
+				_, err = deploymentsFetcher.Deployments(ctx)
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			})
+		})
+
+		ginkgo.Context("when a refresh hangs", func() {
+			var (
+				hung chan struct{}
+			)
+
+			ginkgo.BeforeEach(func() {
+				interval = 100 * time.Millisecond
+				hung = make(chan struct{})
+				boshClient.DeploymentsStub = func() ([]director.Deployment, error) {
+					if boshClient.DeploymentsCallCount() == 1 {
+						<-hung
+					}
+					return []director.Deployment{}, nil
+				}
+			})
+
+			ginkgo.AfterEach(func() {
+				close(hung)
+			})
+
+			ginkgo.It("abandons it and refreshes the snapshot on the next interval", func() {
+				gomega.Eventually(func() time.Time {
+					refreshTimestamp, _ := deploymentsFetcher.LastRefresh()
+					return refreshTimestamp
+				}).ShouldNot(gomega.BeZero())
+				gomega.Expect(boshClient.DeploymentsCallCount()).To(gomega.BeNumerically(">=", 2))
+			})
 		})
 	})
 })
@@ -0,0 +1,95 @@
+package deployments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/cloudfoundry/bosh_exporter/filters"
+)
+
+// WriteSnapshotFile records the deployments read from a BOSH Director into a JSON file, so they can be replayed later
+// with a SnapshotSource.
+func WriteSnapshotFile(filename string, deployments []DeploymentInfo) error {
+	content, err := json.MarshalIndent(deployments, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding deployments snapshot: %v", err)
+	}
+
+	if err := os.WriteFile(filename, content, 0644); err != nil {
+		return fmt.Errorf("error writing deployments snapshot file `%s`: %v", filename, err)
+	}
+
+	return nil
+}
+
+// ReadSnapshotFile reads the deployments recorded into a JSON file with WriteSnapshotFile.
+func ReadSnapshotFile(filename string) ([]DeploymentInfo, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading deployments snapshot file `%s`: %v", filename, err)
+	}
+
+	var deployments []DeploymentInfo
+	if err := json.Unmarshal(content, &deployments); err != nil {
+		return nil, fmt.Errorf("error parsing deployments snapshot file `%s`: %v", filename, err)
+	}
+
+	return deployments, nil
+}
+
+// SnapshotSource serves the deployments recorded into a JSON file instead of reading them from a BOSH Director.
+// The file is read again on every call to Deployments, so it can be edited while the exporter is running.
+type SnapshotSource struct {
+	filename          string
+	deploymentsFilter filters.DeploymentsFilter
+}
+
+// NewSnapshotSource returns a SnapshotSource replaying the deployments recorded into filename. Only the deployments
+// passing deploymentsFilter are served.
+func NewSnapshotSource(filename string, deploymentsFilter filters.DeploymentsFilter) (*SnapshotSource, error) {
+	if _, err := ReadSnapshotFile(filename); err != nil {
+		return nil, err
+	}
+
+	return &SnapshotSource{
+		filename:          filename,
+		deploymentsFilter: deploymentsFilter,
+	}, nil
+}
+
+func (s *SnapshotSource) Deployments(_ context.Context) ([]DeploymentInfo, error) {
+	deployments, err := ReadSnapshotFile(s.filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(deployments, func(deployment DeploymentInfo) bool {
+		return !s.deploymentsFilter.Enabled(deployment.Name)
+	}), nil
+}
+
+// LastRefresh returns a zero time, as the deployments are never fetched from a BOSH Director.
+func (s *SnapshotSource) LastRefresh() (time.Time, time.Duration) {
+	return time.Time{}, 0
+}
+
+// Reachable returns true, as the deployments are never fetched from a BOSH Director.
+func (s *SnapshotSource) Reachable() bool {
+	return true
+}
+
+func (s *SnapshotSource) ReleasesCacheStats() (hits uint64, misses uint64) {
+	return 0, 0
+}
+
+func (s *SnapshotSource) FetchStats() (inFlight int, total uint64, queueWait time.Duration) {
+	return 0, 0, 0
+}
+
+func (s *SnapshotSource) DeploymentsFetchStatus() map[string]DeploymentFetchStatus {
+	return map[string]DeploymentFetchStatus{}
+}
@@ -0,0 +1,143 @@
+package deployments_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/filters"
+)
+
+var _ = ginkgo.Describe("SnapshotSource", func() {
+	var (
+		err               error
+		tmpDir            string
+		filename          string
+		deploymentNames   []string
+		deploymentsInfo   []deployments.DeploymentInfo
+		snapshotSource    *deployments.SnapshotSource
+		processUptime     = uint64(3600)
+		processCPUTotal   = float64(0.5)
+		instanceCreatedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "snapshot_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		filename = filepath.Join(tmpDir, "deployments.json")
+		deploymentNames = nil
+
+		deploymentsInfo = []deployments.DeploymentInfo{
+			{
+				Name: "fake-deployment-1",
+				Instances: []deployments.Instance{
+					{
+						AgentID:     "fake-agent-id",
+						Name:        "fake-job-name",
+						ID:          "fake-job-id",
+						IPs:         []string{"1.2.3.4"},
+						VMCreatedAt: instanceCreatedAt,
+						Processes: []deployments.Process{
+							{
+								Name:   "fake-process-name",
+								Uptime: &processUptime,
+								CPU:    deployments.CPU{Total: &processCPUTotal},
+							},
+						},
+					},
+				},
+				Releases:  []deployments.Release{{Name: "fake-release", Version: "1", JobNames: []string{"fake-job"}}},
+				Stemcells: []deployments.Stemcell{{Name: "fake-stemcell", Version: "2", OSName: "fake-os"}},
+			},
+			{
+				Name: "fake-deployment-2",
+			},
+		}
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		gomega.Expect(deployments.WriteSnapshotFile(filename, deploymentsInfo)).To(gomega.Succeed())
+		snapshotSource, err = deployments.NewSnapshotSource(filename, *filters.NewDeploymentsFilter(deploymentNames, nil))
+	})
+
+	ginkgo.It("replays the recorded deployments", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(snapshotSource.Deployments(context.Background())).To(gomega.Equal(deploymentsInfo))
+	})
+
+	ginkgo.It("does not report any fetch", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		refreshTimestamp, _ := snapshotSource.LastRefresh()
+		gomega.Expect(refreshTimestamp.IsZero()).To(gomega.BeTrue())
+		gomega.Expect(snapshotSource.DeploymentsFetchStatus()).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("reports the BOSH Director as reachable", func() {
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(snapshotSource.Reachable()).To(gomega.BeTrue())
+	})
+
+	ginkgo.Context("when deployments are filtered", func() {
+		ginkgo.BeforeEach(func() {
+			deploymentNames = []string{"fake-deployment-2"}
+		})
+
+		ginkgo.It("replays only the filtered deployments", func() {
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(snapshotSource.Deployments(context.Background())).To(gomega.Equal(deploymentsInfo[1:]))
+		})
+		ginkgo.Context("and the deployment name has leading and/or trailing whitespaces", func() {
+			ginkgo.BeforeEach(func() {
+				deploymentNames = []string{"   fake-deployment-2  "}
+			})
+
+			ginkgo.It("replays only the filtered deployments", func() {
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				gomega.Expect(snapshotSource.Deployments(context.Background())).To(gomega.Equal(deploymentsInfo[1:]))
+			})
+		})
+	})
+
+	ginkgo.Context("when the file is modified", func() {
+		ginkgo.JustBeforeEach(func() {
+			gomega.Expect(deployments.WriteSnapshotFile(filename, deploymentsInfo[:1])).To(gomega.Succeed())
+		})
+
+		ginkgo.It("replays the new deployments", func() {
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(snapshotSource.Deployments(context.Background())).To(gomega.Equal(deploymentsInfo[:1]))
+		})
+	})
+
+	ginkgo.Context("when the file is not a deployments snapshot", func() {
+		ginkgo.JustBeforeEach(func() {
+			gomega.Expect(os.WriteFile(filename, []byte("{}"), 0600)).To(gomega.Succeed())
+			snapshotSource, err = deployments.NewSnapshotSource(filename, *filters.NewDeploymentsFilter(deploymentNames, nil))
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("error parsing deployments snapshot file"))
+		})
+	})
+
+	ginkgo.Context("when the file does not exist", func() {
+		ginkgo.JustBeforeEach(func() {
+			snapshotSource, err = deployments.NewSnapshotSource(filepath.Join(tmpDir, "missing.json"), *filters.NewDeploymentsFilter(deploymentNames, nil))
+		})
+
+		ginkgo.It("returns an error", func() {
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("error reading deployments snapshot file"))
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package e2e_test
+
+import (
+	"testing"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+var exporterPath string
+
+func TestE2E(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "E2E Suite")
+}
+
+var _ = ginkgo.SynchronizedBeforeSuite(func() []byte {
+	path, err := gexec.Build("github.com/cloudfoundry/bosh_exporter")
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	return []byte(path)
+}, func(path []byte) {
+	exporterPath = string(path)
+})
+
+var _ = ginkgo.SynchronizedAfterSuite(func() {}, func() {
+	gexec.CleanupBuildArtifacts()
+})
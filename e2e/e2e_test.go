@@ -0,0 +1,785 @@
+package e2e_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/cloudfoundry/bosh_exporter/utils/fakebosh"
+)
+
+var _ = ginkgo.Describe("bosh_exporter", func() {
+	var (
+		err        error
+		tmpDir     string
+		sdFilename string
+		listenAddr string
+		args       []string
+		authArgs   []string
+		director   *fakebosh.Director
+		session    *gexec.Session
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "e2e_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+		director = fakebosh.NewDirector()
+		caCertFile := filepath.Join(tmpDir, "ca.crt")
+		gomega.Expect(os.WriteFile(caCertFile, director.CACert(), 0600)).To(gomega.Succeed())
+
+		sdFilename = filepath.Join(tmpDir, "bosh_target_groups.json")
+		listenAddr = freeListenAddress()
+
+		args = []string{
+			"--bosh.url=" + director.URL(),
+			"--bosh.ca-cert-file=" + caCertFile,
+			"--bosh.connect-min-backoff=100ms",
+			"--bosh.connect-max-backoff=1s",
+			"--metrics.environment=e2e",
+			"--sd.filename=" + sdFilename,
+			"--web.listen-address=" + listenAddr,
+		}
+		authArgs = []string{
+			"--bosh.uaa.client-id=" + fakebosh.ClientID,
+			"--bosh.uaa.client-secret=" + fakebosh.ClientSecret,
+		}
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		session, err = gexec.Start(exec.Command(exporterPath, append(args, authArgs...)...), ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	})
+
+	ginkgo.AfterEach(func() {
+		session.Kill().Wait()
+		director.Close()
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	scrape := func() string {
+		resp, err := http.Get("http://" + listenAddr + "/metrics")
+		if err != nil {
+			return ""
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		return string(body)
+	}
+
+	itExportsTheDirectorMetrics := func() {
+		ginkgo.It("exports the BOSH Director metrics", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+				gomega.ContainSubstring(fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 1`, director.URL())),
+				gomega.ContainSubstring(
+					fmt.Sprintf(
+						`bosh_last_scrape_error{bosh_name="%s",bosh_uuid="%s",environment="e2e"} 0`,
+						fakebosh.Name, fakebosh.UUID,
+					),
+				),
+				gomega.MatchRegexp(
+					`bosh_job_process_healthy{bosh_deployment="cf",bosh_job_az="z1",bosh_job_id="[^"]+",`+
+						`bosh_job_index="0",bosh_job_ip="10.244.0.34",bosh_job_name="router",`+
+						`bosh_job_process_name="gorouter",bosh_name="fake-director",bosh_uuid="fake-director-uuid",`+
+						`environment="e2e"} 1`,
+				),
+				gomega.MatchRegexp(
+					`bosh_job_healthy{bosh_deployment="cf",bosh_job_az="z2",bosh_job_id="[^"]+",`+
+						`bosh_job_index="0",bosh_job_ip="10.244.0.35",bosh_job_name="api",`+
+						`bosh_name="fake-director",bosh_uuid="fake-director-uuid",environment="e2e"} 0`,
+				),
+				gomega.ContainSubstring(
+					`bosh_deployment_release_info{bosh_deployment="redis",bosh_name="fake-director",`+
+						`bosh_release_name="redis",bosh_release_version="15.0.0",bosh_uuid="fake-director-uuid",`+
+						`environment="e2e"} 1`,
+				),
+				gomega.ContainSubstring(
+					`bosh_deployment_stemcell_info{bosh_deployment="cf",bosh_name="fake-director",`+
+						`bosh_stemcell_name="bosh-warden-boshlite-ubuntu-jammy-go_agent",`+
+						`bosh_stemcell_os_name="",bosh_stemcell_version="1.423",`+
+						`bosh_uuid="fake-director-uuid",environment="e2e"} 1`,
+				),
+			))
+		})
+
+		ginkgo.It("writes the service discovery file", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring("bosh_last_service_discovery_scrape_timestamp"))
+
+			content, err := os.ReadFile(sdFilename)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			var targetGroups []struct {
+				Targets []string          `json:"targets"`
+				Labels  map[string]string `json:"labels"`
+			}
+			gomega.Expect(json.Unmarshal(content, &targetGroups)).To(gomega.Succeed())
+			gomega.Expect(targetGroups).To(gomega.ConsistOf(
+				gomega.And(
+					gomega.HaveField("Targets", gomega.ConsistOf("10.244.0.34")),
+					gomega.HaveField("Labels", gomega.And(
+						gomega.HaveKeyWithValue("__meta_bosh_deployment", "cf"),
+						gomega.HaveKeyWithValue("__meta_bosh_job_process_name", "gorouter"),
+						gomega.HaveKeyWithValue("__meta_bosh_job_process_release", "routing:0.283.0"),
+					)),
+				),
+				gomega.And(
+					gomega.HaveField("Targets", gomega.ConsistOf("10.244.0.34")),
+					gomega.HaveField("Labels", gomega.HaveKeyWithValue("__meta_bosh_job_process_name", "route_registrar")),
+				),
+				gomega.And(
+					gomega.HaveField("Targets", gomega.ConsistOf("10.244.0.35")),
+					gomega.HaveField("Labels", gomega.HaveKeyWithValue("__meta_bosh_job_process_name", "cloud_controller_ng")),
+				),
+				gomega.And(
+					gomega.HaveField("Targets", gomega.ConsistOf("10.244.1.10")),
+					gomega.HaveField("Labels", gomega.And(
+						gomega.HaveKeyWithValue("__meta_bosh_deployment", "redis"),
+						gomega.HaveKeyWithValue("__meta_bosh_job_process_name", "redis-server"),
+					)),
+				),
+			))
+		})
+	}
+
+	ginkgo.Context("when authenticating with UAA client credentials", func() {
+		itExportsTheDirectorMetrics()
+
+		ginkgo.It("lists the instances of the deployments through BOSH Director tasks", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring("bosh_job_healthy"))
+			gomega.Expect(director.Requests("GET /tasks/{id}")).To(gomega.BeNumerically(">=", 2))
+		})
+	})
+
+	ginkgo.Context("when authenticating with a username and password", func() {
+		ginkgo.BeforeEach(func() {
+			authArgs = []string{
+				"--bosh.username=" + fakebosh.Username,
+				"--bosh.password=" + fakebosh.Password,
+			}
+		})
+
+		itExportsTheDirectorMetrics()
+
+		ginkgo.It("exports the expiry of the UAA access token", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.MatchRegexp(
+				`bosh_uaa_token_expiry_timestamp_seconds{bosh_name="fake-director",bosh_uuid="fake-director-uuid",` +
+					`environment="e2e"} \d\.\d+e\+09`,
+			))
+		})
+
+		ginkgo.Context("when the access token expires", func() {
+			ginkgo.It("refreshes it with the refresh token", func() {
+				gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring("bosh_job_healthy"))
+
+				director.UAA().RevokeAccessTokens()
+
+				gomega.Expect(scrape()).To(gomega.And(
+					gomega.ContainSubstring(`bosh_last_scrape_error{bosh_name="fake-director",bosh_uuid="fake-director-uuid",environment="e2e"} 0`),
+					gomega.ContainSubstring(`bosh_uaa_token_refresh_failures_total{bosh_name="fake-director",bosh_uuid="fake-director-uuid",environment="e2e"} 0`),
+				))
+			})
+		})
+
+		ginkgo.Context("when the refresh token expires too", func() {
+			ginkgo.It("requests a new access token with the user credentials", func() {
+				gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring("bosh_job_healthy"))
+
+				director.UAA().RevokeTokens()
+
+				gomega.Expect(scrape()).To(gomega.And(
+					gomega.ContainSubstring(`bosh_last_scrape_error{bosh_name="fake-director",bosh_uuid="fake-director-uuid",environment="e2e"} 0`),
+					gomega.ContainSubstring(`bosh_uaa_token_refresh_failures_total{bosh_name="fake-director",bosh_uuid="fake-director-uuid",environment="e2e"} 1`),
+				))
+			})
+		})
+	})
+
+	ginkgo.Context("when the UAA client credentials are wrong", func() {
+		ginkgo.BeforeEach(func() {
+			authArgs = []string{
+				"--bosh.uaa.client-id=" + fakebosh.ClientID,
+				"--bosh.uaa.client-secret=wrong-secret",
+			}
+		})
+
+		ginkgo.It("keeps retrying to connect to the BOSH Director", func() {
+			gomega.Eventually(session.Err, 10*time.Second).Should(gbytes.Say("Error connecting to BOSH Director"))
+			gomega.Eventually(session.Err, 10*time.Second).Should(gbytes.Say("Error connecting to BOSH Director"))
+			gomega.Expect(session).ToNot(gexec.Exit())
+		})
+
+		ginkgo.It("only exports the exporter's own metrics", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring(
+				fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 0`, director.URL()),
+			))
+			gomega.Expect(scrape()).ToNot(gomega.ContainSubstring("bosh_last_scrape_error"))
+		})
+	})
+
+	ginkgo.Context("when the BOSH Director is reached through a jumpbox", func() {
+		var (
+			jumpbox  *fakebosh.Jumpbox
+			allProxy string
+		)
+
+		ginkgo.BeforeEach(func() {
+			jumpbox = fakebosh.NewJumpbox()
+			privateKeyFile := filepath.Join(tmpDir, "jumpbox.key")
+			gomega.Expect(os.WriteFile(privateKeyFile, jumpbox.PrivateKey(), 0600)).To(gomega.Succeed())
+
+			allProxy = fmt.Sprintf("ssh+socks5://%s@%s?private-key=%s", fakebosh.JumpboxUsername, jumpbox.Addr(), privateKeyFile)
+			args = append(args, "--bosh.all-proxy="+allProxy)
+		})
+
+		ginkgo.AfterEach(func() {
+			jumpbox.Close()
+		})
+
+		itExportsTheDirectorMetrics()
+
+		ginkgo.It("tunnels the BOSH Director and UAA requests through the jumpbox", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring("bosh_job_healthy"))
+			gomega.Expect(jumpbox.Forwarded()).To(gomega.BeNumerically(">=", director.Requests("GET /info")))
+		})
+
+		ginkgo.It("reconnects to the jumpbox when the SSH connection is lost", func() {
+			tunnelMetric := func(name string, value int) string {
+				return fmt.Sprintf(`bosh_ssh_tunnel_%s{proxy="ssh+socks5://%s@%s"} %d`, name, fakebosh.JumpboxUsername, jumpbox.Addr(), value)
+			}
+
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+				gomega.ContainSubstring("bosh_job_healthy"),
+				gomega.ContainSubstring(tunnelMetric("up", 1)),
+				gomega.ContainSubstring(tunnelMetric("reconnects_total", 0)),
+			))
+
+			jumpbox.DropConnections()
+
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+				gomega.ContainSubstring(`bosh_last_scrape_error{bosh_name="fake-director",bosh_uuid="fake-director-uuid",environment="e2e"} 0`),
+				gomega.ContainSubstring(tunnelMetric("up", 1)),
+				gomega.ContainSubstring(tunnelMetric("reconnects_total", 1)),
+			))
+		})
+
+		ginkgo.Context("when the BOSH Director no longer uses the jumpbox after a reload", func() {
+			var configFile string
+
+			ginkgo.BeforeEach(func() {
+				configFile = filepath.Join(tmpDir, "config.yml")
+				content := fmt.Sprintf("url: %s\nall_proxy: %s\n", director.URL(), allProxy)
+				gomega.Expect(os.WriteFile(configFile, []byte(content), 0600)).To(gomega.Succeed())
+
+				args = slices.DeleteFunc(args, func(arg string) bool {
+					return strings.HasPrefix(arg, "--bosh.url=") || strings.HasPrefix(arg, "--bosh.all-proxy=")
+				})
+				args = append(args, "--config.file="+configFile)
+			})
+
+			ginkgo.It("closes the SSH tunnel", func() {
+				gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+					gomega.ContainSubstring("bosh_job_healthy"),
+					gomega.ContainSubstring("bosh_ssh_tunnel_up"),
+				))
+
+				gomega.Expect(os.WriteFile(configFile, []byte(fmt.Sprintf("url: %s\n", director.URL())), 0600)).To(gomega.Succeed())
+				resp, err := http.Post("http://"+listenAddr+"/-/reload", "", nil)
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				gomega.Expect(resp.Body.Close()).To(gomega.Succeed())
+				gomega.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+
+				gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+					gomega.ContainSubstring(fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 1`, director.URL())),
+					gomega.Not(gomega.ContainSubstring("bosh_ssh_tunnel_up")),
+				))
+			})
+		})
+	})
+
+	ginkgo.Context("when the UAA client secret is read from a file", func() {
+		var secretFile string
+
+		ginkgo.BeforeEach(func() {
+			secretFile = filepath.Join(tmpDir, "uaa-client-secret")
+			gomega.Expect(os.WriteFile(secretFile, []byte("wrong-secret\n"), 0600)).To(gomega.Succeed())
+
+			args = append(args, "--secrets.check-interval=100ms")
+			authArgs = []string{
+				"--bosh.uaa.client-id=" + fakebosh.ClientID,
+				"--bosh.uaa.client-secret-file=" + secretFile,
+			}
+		})
+
+		ginkgo.It("connects to the BOSH Director once the secret is rotated", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring(
+				fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 0`, director.URL()),
+			))
+
+			gomega.Expect(os.WriteFile(secretFile, []byte(fakebosh.ClientSecret+"\n"), 0600)).To(gomega.Succeed())
+
+			gomega.Eventually(session.Err, 10*time.Second).Should(gbytes.Say("Secret files changed, reloading configuration"))
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+				gomega.ContainSubstring(fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 1`, director.URL())),
+				gomega.ContainSubstring("bosh_job_healthy"),
+			))
+		})
+	})
+
+	ginkgo.Context("when basic auth users are set in the web configuration file", func() {
+		var webConfigFile string
+
+		writeWebConfig := func(password string) {
+			hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			content := fmt.Sprintf("basic_auth_users:\n  fake-username: %s\n", hash)
+			gomega.Expect(os.WriteFile(webConfigFile, []byte(content), 0600)).To(gomega.Succeed())
+		}
+
+		statusCode := func(method string, path string, password string) func() int {
+			return func() int {
+				req, err := http.NewRequest(method, "http://"+listenAddr+path, nil)
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				if password != "" {
+					req.SetBasicAuth("fake-username", password)
+				}
+
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					return 0
+				}
+				defer resp.Body.Close()
+
+				return resp.StatusCode
+			}
+		}
+
+		ginkgo.BeforeEach(func() {
+			webConfigFile = filepath.Join(tmpDir, "web-config.yml")
+			writeWebConfig("fake-password")
+
+			args = append(args, "--web.config.file="+webConfigFile)
+		})
+
+		ginkgo.It("requires the credentials of a user on every endpoint", func() {
+			gomega.Eventually(statusCode(http.MethodGet, "/metrics", "fake-password"), 10*time.Second).Should(gomega.Equal(http.StatusOK))
+			gomega.Expect(statusCode(http.MethodGet, "/metrics", "")()).To(gomega.Equal(http.StatusUnauthorized))
+			gomega.Expect(statusCode(http.MethodGet, "/metrics", "wrong-password")()).To(gomega.Equal(http.StatusUnauthorized))
+			gomega.Expect(statusCode(http.MethodGet, "/", "")()).To(gomega.Equal(http.StatusUnauthorized))
+			gomega.Expect(statusCode(http.MethodGet, "/probe", "")()).To(gomega.Equal(http.StatusUnauthorized))
+			gomega.Expect(statusCode(http.MethodPost, "/-/reload", "")()).To(gomega.Equal(http.StatusUnauthorized))
+			gomega.Expect(statusCode(http.MethodPost, "/-/reload", "fake-password")()).To(gomega.Equal(http.StatusOK))
+		})
+
+		ginkgo.It("only accepts the current password once the file is rotated", func() {
+			gomega.Eventually(statusCode(http.MethodGet, "/metrics", "fake-password"), 10*time.Second).Should(gomega.Equal(http.StatusOK))
+
+			writeWebConfig("fake-new-password")
+
+			gomega.Expect(statusCode(http.MethodGet, "/metrics", "fake-new-password")()).To(gomega.Equal(http.StatusOK))
+			gomega.Expect(statusCode(http.MethodGet, "/metrics", "fake-password")()).To(gomega.Equal(http.StatusUnauthorized))
+		})
+
+		ginkgo.Context("when a password is not hashed", func() {
+			ginkgo.BeforeEach(func() {
+				gomega.Expect(os.WriteFile(webConfigFile, []byte("basic_auth_users:\n  fake-username: fake-password\n"), 0600)).To(gomega.Succeed())
+			})
+
+			ginkgo.It("fails to start", func() {
+				gomega.Eventually(session, 10*time.Second).Should(gexec.Exit(1))
+				gomega.Expect(session.Err).To(gbytes.Say("Invalid web configuration file"))
+			})
+		})
+	})
+
+	ginkgo.Context("when TLS is set in the web configuration file", func() {
+		var (
+			ca            *testCA
+			clientCert    tls.Certificate
+			webConfigFile string
+		)
+
+		get := func(tlsConfig *tls.Config) (*http.Response, error) {
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig, ForceAttemptHTTP2: true}}
+			resp, err := client.Get("https://" + listenAddr + "/metrics")
+			if err != nil {
+				return nil, err
+			}
+			_ = resp.Body.Close()
+			return resp, nil
+		}
+
+		writeWebConfig := func(http2 bool) {
+			serverCert := ca.issue("127.0.0.1", x509.ExtKeyUsageServerAuth)
+			certFile, keyFile := ca.write(tmpDir, "server", serverCert)
+			caFile := filepath.Join(tmpDir, "ca.pem")
+			gomega.Expect(os.WriteFile(caFile, ca.certPEM, 0600)).To(gomega.Succeed())
+
+			content := fmt.Sprintf(`
+tls_server_config:
+  cert_file: %s
+  key_file: %s
+  client_ca_file: %s
+  client_auth_type: RequireAndVerifyClientCert
+  min_version: TLS13
+http_server_config:
+  http2: %t
+`, certFile, keyFile, caFile, http2)
+			gomega.Expect(os.WriteFile(webConfigFile, []byte(content), 0600)).To(gomega.Succeed())
+		}
+
+		ginkgo.BeforeEach(func() {
+			ca = newTestCA()
+			clientCert = ca.issue("fake-client", x509.ExtKeyUsageClientAuth)
+
+			webConfigFile = filepath.Join(tmpDir, "web-config.yml")
+			writeWebConfig(false)
+
+			args = append(args, "--web.config.file="+webConfigFile)
+		})
+
+		ginkgo.It("serves the metrics to the clients with a certificate", func() {
+			tlsConfig := &tls.Config{RootCAs: ca.pool(), Certificates: []tls.Certificate{clientCert}}
+
+			var resp *http.Response
+			gomega.Eventually(func() error {
+				resp, err = get(tlsConfig)
+				return err
+			}, 10*time.Second).Should(gomega.Succeed())
+			gomega.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+			gomega.Expect(resp.TLS.Version).To(gomega.Equal(uint16(tls.VersionTLS13)))
+			gomega.Expect(resp.ProtoMajor).To(gomega.Equal(1))
+		})
+
+		ginkgo.It("rejects the clients without a certificate", func() {
+			gomega.Eventually(func() error {
+				_, err = get(&tls.Config{RootCAs: ca.pool()})
+				return err
+			}, 10*time.Second).Should(gomega.MatchError(gomega.ContainSubstring("certificate required")))
+		})
+
+		ginkgo.It("rejects the clients below the minimum TLS version", func() {
+			tlsConfig := &tls.Config{RootCAs: ca.pool(), Certificates: []tls.Certificate{clientCert}, MaxVersion: tls.VersionTLS12}
+
+			gomega.Eventually(func() error {
+				_, err = get(tlsConfig)
+				return err
+			}, 10*time.Second).Should(gomega.MatchError(gomega.ContainSubstring("protocol version")))
+		})
+
+		ginkgo.Context("when HTTP/2 is enabled", func() {
+			ginkgo.BeforeEach(func() {
+				writeWebConfig(true)
+			})
+
+			ginkgo.It("serves the metrics over HTTP/2", func() {
+				tlsConfig := &tls.Config{RootCAs: ca.pool(), Certificates: []tls.Certificate{clientCert}}
+
+				var resp *http.Response
+				gomega.Eventually(func() error {
+					resp, err = get(tlsConfig)
+					return err
+				}, 10*time.Second).Should(gomega.Succeed())
+				gomega.Expect(resp.ProtoMajor).To(gomega.Equal(2))
+			})
+		})
+	})
+
+	ginkgo.Context("when the deprecated web.auth.* and web.tls.* flags are set", func() {
+		var ca *testCA
+
+		get := func(username string, password string) (*http.Response, error) {
+			req, err := http.NewRequest(http.MethodGet, "https://"+listenAddr+"/metrics", nil)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			if username != "" {
+				req.SetBasicAuth(username, password)
+			}
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: ca.pool()}}}
+			resp, err := client.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			_ = resp.Body.Close()
+			return resp, nil
+		}
+
+		ginkgo.BeforeEach(func() {
+			ca = newTestCA()
+			certFile, keyFile := ca.write(tmpDir, "server", ca.issue("127.0.0.1", x509.ExtKeyUsageServerAuth))
+
+			args = append(args,
+				"--web.auth.username=fake-username",
+				"--web.auth.password=fake-password",
+				"--web.tls.cert_file="+certFile,
+				"--web.tls.key_file="+keyFile,
+			)
+		})
+
+		ginkgo.It("serves the metrics over TLS with basic auth", func() {
+			var resp *http.Response
+			gomega.Eventually(func() error {
+				resp, err = get("fake-username", "fake-password")
+				return err
+			}, 10*time.Second).Should(gomega.Succeed())
+			gomega.Expect(resp.StatusCode).To(gomega.Equal(http.StatusOK))
+			gomega.Expect(session.Err).To(gbytes.Say("deprecated"))
+
+			resp, err = get("fake-username", "wrong-password")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(resp.StatusCode).To(gomega.Equal(http.StatusUnauthorized))
+
+			resp, err = get("", "")
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(resp.StatusCode).To(gomega.Equal(http.StatusUnauthorized))
+		})
+
+		ginkgo.Context("when the web configuration file is set too", func() {
+			ginkgo.BeforeEach(func() {
+				webConfigFile := filepath.Join(tmpDir, "web-config.yml")
+				gomega.Expect(os.WriteFile(webConfigFile, []byte("{}\n"), 0600)).To(gomega.Succeed())
+				args = append(args, "--web.config.file="+webConfigFile)
+			})
+
+			ginkgo.It("fails to start", func() {
+				gomega.Eventually(session, 10*time.Second).Should(gexec.Exit(1))
+				gomega.Expect(session.Err).To(gbytes.Say("cannot be used with the web.config.file flag"))
+			})
+		})
+	})
+
+	ginkgo.Context("when probe modules are set", func() {
+		type probeResponse struct {
+			StatusCode int
+			Body       string
+		}
+
+		probeModule := func(target string, module string, scrapeTimeout time.Duration) func() probeResponse {
+			return func() probeResponse {
+				query := url.Values{"target": {target}, "module": {module}}
+				req, err := http.NewRequest(http.MethodGet, "http://"+listenAddr+"/probe?"+query.Encode(), nil)
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				if scrapeTimeout > 0 {
+					req.Header.Set("X-Prometheus-Scrape-Timeout-Seconds", fmt.Sprint(scrapeTimeout.Seconds()))
+				}
+
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					return probeResponse{}
+				}
+				defer resp.Body.Close()
+
+				body, err := io.ReadAll(resp.Body)
+				gomega.Expect(err).ToNot(gomega.HaveOccurred())
+				return probeResponse{StatusCode: resp.StatusCode, Body: string(body)}
+			}
+		}
+
+		probe := func(target string) func() probeResponse {
+			return probeModule(target, "default", 0)
+		}
+
+		ginkgo.BeforeEach(func() {
+			modulesFile := filepath.Join(tmpDir, "modules.yml")
+			target := regexp.QuoteMeta(director.URL())
+			content := fmt.Sprintf("modules:\n  default:\n    targets: ['%s']\n  slow:\n    targets: ['%s']\n", target, target)
+			gomega.Expect(os.WriteFile(modulesFile, []byte(content), 0600)).To(gomega.Succeed())
+
+			args = append(args, "--probe.modules-file="+modulesFile)
+		})
+
+		ginkgo.It("exports the metrics of an allowed target", func() {
+			gomega.Eventually(probe(director.URL()), 10*time.Second).Should(gomega.And(
+				gomega.HaveField("StatusCode", http.StatusOK),
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_probe_success 1")),
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_job_healthy")),
+			))
+		})
+
+		ginkgo.It("fails the probe when the BOSH Director cannot be scraped", func() {
+			gomega.Eventually(probe(director.URL()), 10*time.Second).Should(
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_probe_success 1")),
+			)
+
+			director.SetAvailable(false)
+
+			gomega.Eventually(probe(director.URL()), 10*time.Second).Should(gomega.And(
+				gomega.HaveField("StatusCode", http.StatusOK),
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_probe_success 0")),
+			))
+		})
+
+		ginkgo.It("keeps creating the BOSH client of a target slower to answer than the scrape timeout", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring("bosh_job_healthy"))
+			infoRequests := director.Requests("GET /info")
+			gomega.Eventually(probe(director.URL()), 10*time.Second).Should(
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_probe_success 1")),
+			)
+			clientInfoRequests := director.Requests("GET /info") - infoRequests
+
+			ginkgo.By("probing it with another module while it is slow")
+			director.SetLatency(500 * time.Millisecond)
+			gomega.Expect(probeModule(director.URL(), "slow", 100*time.Millisecond)()).To(
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_probe_success 0")),
+			)
+
+			director.SetLatency(0)
+			gomega.Eventually(probeModule(director.URL(), "slow", 0), 10*time.Second).Should(
+				gomega.HaveField("Body", gomega.ContainSubstring("bosh_probe_success 1")),
+			)
+			gomega.Expect(director.Requests("GET /info")).To(gomega.Equal(infoRequests + 2*clientInfoRequests))
+		})
+
+		ginkgo.It("rejects the targets not allowed by the module", func() {
+			gomega.Eventually(probe(director.URL()+".fake-attacker"), 10*time.Second).Should(gomega.And(
+				gomega.HaveField("StatusCode", http.StatusBadRequest),
+				gomega.HaveField("Body", gomega.ContainSubstring("is not allowed by module `default`")),
+			))
+		})
+	})
+
+	ginkgo.Context("when the BOSH Director cannot be reached at startup", func() {
+		ginkgo.BeforeEach(func() {
+			director.SetAvailable(false)
+		})
+
+		ginkgo.It("exports the BOSH Director metrics once it can be reached", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring(
+				fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 0`, director.URL()),
+			))
+			gomega.Expect(scrape()).ToNot(gomega.ContainSubstring("bosh_job_healthy"))
+
+			director.SetAvailable(true)
+
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.And(
+				gomega.ContainSubstring(fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 1`, director.URL())),
+				gomega.ContainSubstring("bosh_job_healthy"),
+			))
+		})
+	})
+
+	ginkgo.Context("when the BOSH Director goes away after the exporter connected to it", func() {
+		ginkgo.It("reports it down until it is back", func() {
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring(
+				fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 1`, director.URL()),
+			))
+
+			director.SetAvailable(false)
+
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring(
+				fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 0`, director.URL()),
+			))
+
+			director.SetAvailable(true)
+
+			gomega.Eventually(scrape, 10*time.Second).Should(gomega.ContainSubstring(
+				fmt.Sprintf(`bosh_director_up{bosh_url="%s",environment="e2e"} 1`, director.URL()),
+			))
+		})
+	})
+})
+
+// testCA issues the certificates of the TLS tests.
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+func newTestCA() *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	return &testCA{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+// issue returns a certificate for commonName, valid for 127.0.0.1 too.
+func (ca *testCA) issue(commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// write writes the PEM-encoded certificate and key into dir, and returns their filenames.
+func (ca *testCA) write(dir string, name string, cert tls.Certificate) (string, string) {
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+	certFile := filepath.Join(dir, name+".crt")
+	gomega.Expect(os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600)).To(gomega.Succeed())
+	keyFile := filepath.Join(dir, name+".key")
+	gomega.Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600)).To(gomega.Succeed())
+
+	return certFile, keyFile
+}
+
+func freeListenAddress() string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
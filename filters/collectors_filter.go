@@ -2,15 +2,29 @@ package filters
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
 const (
+	CertificatesCollector     = "Certificates"
 	DeploymentsCollector      = "Deployments"
+	DirectorCollector         = "Director"
+	EventsCollector           = "Events"
 	JobsCollector             = "Jobs"
+	OrphansCollector          = "Orphans"
 	ServiceDiscoveryCollector = "ServiceDiscovery"
+	TasksCollector            = "Tasks"
 )
 
+// DefaultCollectors are the names of the collectors enabled when no collector filter is set. The other collectors
+// call the BOSH Director on every scrape, and must be enabled explicitly.
+var DefaultCollectors = []string{
+	DeploymentsCollector,
+	JobsCollector,
+	ServiceDiscoveryCollector,
+}
+
 type CollectorsFilter struct {
 	collectorsEnabled map[string]bool
 }
@@ -20,12 +34,22 @@ func NewCollectorsFilter(filters []string) (*CollectorsFilter, error) {
 
 	for _, collectorName := range filters {
 		switch strings.Trim(collectorName, " ") {
+		case CertificatesCollector:
+			collectorsEnabled[CertificatesCollector] = true
 		case DeploymentsCollector:
 			collectorsEnabled[DeploymentsCollector] = true
+		case DirectorCollector:
+			collectorsEnabled[DirectorCollector] = true
+		case EventsCollector:
+			collectorsEnabled[EventsCollector] = true
 		case JobsCollector:
 			collectorsEnabled[JobsCollector] = true
+		case OrphansCollector:
+			collectorsEnabled[OrphansCollector] = true
 		case ServiceDiscoveryCollector:
 			collectorsEnabled[ServiceDiscoveryCollector] = true
+		case TasksCollector:
+			collectorsEnabled[TasksCollector] = true
 		default:
 			return &CollectorsFilter{}, fmt.Errorf("collector filter `%s` is not supported", collectorName)
 		}
@@ -36,7 +60,7 @@ func NewCollectorsFilter(filters []string) (*CollectorsFilter, error) {
 
 func (f *CollectorsFilter) Enabled(collectorName string) bool {
 	if len(f.collectorsEnabled) == 0 {
-		return true
+		return slices.Contains(DefaultCollectors, collectorName)
 	}
 
 	if f.collectorsEnabled[collectorName] {
@@ -22,7 +22,7 @@ var _ = ginkgo.Describe("CollectorsFilter", func() {
 	ginkgo.Describe("New", func() {
 		ginkgo.Context("when filters are supported", func() {
 			ginkgo.BeforeEach(func() {
-				filtersArray = []string{filters.DeploymentsCollector, filters.JobsCollector, filters.ServiceDiscoveryCollector}
+				filtersArray = []string{filters.CertificatesCollector, filters.DeploymentsCollector, filters.DirectorCollector, filters.EventsCollector, filters.JobsCollector, filters.OrphansCollector, filters.ServiceDiscoveryCollector, filters.TasksCollector}
 			})
 
 			ginkgo.It("does not return an error", func() {
@@ -74,8 +74,18 @@ var _ = ginkgo.Describe("CollectorsFilter", func() {
 				filtersArray = []string{}
 			})
 
-			ginkgo.It("returns true", func() {
+			ginkgo.It("returns true for the default collectors", func() {
+				gomega.Expect(collectorsFilter.Enabled(filters.DeploymentsCollector)).To(gomega.BeTrue())
 				gomega.Expect(collectorsFilter.Enabled(filters.JobsCollector)).To(gomega.BeTrue())
+				gomega.Expect(collectorsFilter.Enabled(filters.ServiceDiscoveryCollector)).To(gomega.BeTrue())
+			})
+
+			ginkgo.It("returns false for the other collectors", func() {
+				gomega.Expect(collectorsFilter.Enabled(filters.CertificatesCollector)).To(gomega.BeFalse())
+				gomega.Expect(collectorsFilter.Enabled(filters.DirectorCollector)).To(gomega.BeFalse())
+				gomega.Expect(collectorsFilter.Enabled(filters.EventsCollector)).To(gomega.BeFalse())
+				gomega.Expect(collectorsFilter.Enabled(filters.OrphansCollector)).To(gomega.BeFalse())
+				gomega.Expect(collectorsFilter.Enabled(filters.TasksCollector)).To(gomega.BeFalse())
 			})
 		})
 	})
@@ -1,11 +1,14 @@
 package filters
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/cloudfoundry/bosh-cli/director"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
 )
 
 type DeploymentsFilter struct {
@@ -17,14 +20,32 @@ func NewDeploymentsFilter(filters []string, boshClient director.Director) *Deplo
 	return &DeploymentsFilter{filters: filters, boshClient: boshClient}
 }
 
-func (f *DeploymentsFilter) GetDeployments() ([]director.Deployment, error) {
+// Enabled tells whether the deployment named deploymentName passes the filter, for the deployments not read from the
+// BOSH Director.
+func (f *DeploymentsFilter) Enabled(deploymentName string) bool {
+	if len(f.filters) == 0 {
+		return true
+	}
+
+	for _, filter := range f.filters {
+		if strings.Trim(filter, " ") == deploymentName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *DeploymentsFilter) GetDeployments(ctx context.Context) ([]director.Deployment, error) {
 	var err error
 	var deployments []director.Deployment
 
 	if len(f.filters) > 0 {
 		log.Debugf("Filtering deployments by `%v`...", f.filters)
 		for _, deploymentName := range f.filters {
-			deployment, err := f.boshClient.FindDeployment(strings.Trim(deploymentName, " "))
+			deployment, err := ctxcall.Do(ctx, func() (director.Deployment, error) {
+				return f.boshClient.FindDeployment(strings.Trim(deploymentName, " "))
+			})
 			if err != nil {
 				return deployments, fmt.Errorf("error while reading deployment `%s`: %v", deploymentName, err)
 			}
@@ -32,7 +53,7 @@ func (f *DeploymentsFilter) GetDeployments() ([]director.Deployment, error) {
 		}
 	} else {
 		log.Debugf("Reading deployments...")
-		deployments, err = f.boshClient.Deployments()
+		deployments, err = ctxcall.Do(ctx, f.boshClient.Deployments)
 		if err != nil {
 			return deployments, fmt.Errorf("error while reading deployments: %v", err)
 		}
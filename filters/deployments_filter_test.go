@@ -1,6 +1,7 @@
 package filters_test
 
 import (
+	"context"
 	"errors"
 
 	"github.com/onsi/ginkgo/v2"
@@ -26,6 +27,7 @@ var _ = ginkgo.Describe("DeploymentsFilter", func() {
 			deployment2    director.Deployment
 			allDeployments []director.Deployment
 
+			ctx         context.Context
 			deployments []director.Deployment
 		)
 
@@ -40,11 +42,12 @@ var _ = ginkgo.Describe("DeploymentsFilter", func() {
 				NameStub: func() string { return "fake-deployment-name-2" },
 			}
 			allDeployments = []director.Deployment{}
+			ctx = context.Background()
 		})
 
 		ginkgo.JustBeforeEach(func() {
 			deploymentsFilter = filters.NewDeploymentsFilter(filtersArray, boshClient)
-			deployments, err = deploymentsFilter.GetDeployments()
+			deployments, err = deploymentsFilter.GetDeployments(ctx)
 		})
 
 		ginkgo.Context("when there are no filters", func() {
@@ -80,6 +83,20 @@ var _ = ginkgo.Describe("DeploymentsFilter", func() {
 			})
 		})
 
+		ginkgo.Context("when the context is done", func() {
+			ginkgo.BeforeEach(func() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(context.Background())
+				cancel()
+			})
+
+			ginkgo.It("does not read the deployments", func() {
+				gomega.Expect(boshClient.DeploymentsCallCount()).To(gomega.Equal(0))
+				gomega.Expect(deployments).To(gomega.BeEmpty())
+				gomega.Expect(err).To(gomega.HaveOccurred())
+			})
+		})
+
 		ginkgo.Context("when there are filters", func() {
 			ginkgo.BeforeEach(func() {
 				filtersArray = []string{"fake-deployment-name-1"}
@@ -118,4 +135,46 @@ var _ = ginkgo.Describe("DeploymentsFilter", func() {
 			})
 		})
 	})
+
+	ginkgo.Describe("Enabled", func() {
+		ginkgo.BeforeEach(func() {
+			filtersArray = []string{"fake-deployment-name-1"}
+		})
+
+		ginkgo.JustBeforeEach(func() {
+			deploymentsFilter = filters.NewDeploymentsFilter(filtersArray, nil)
+		})
+
+		ginkgo.Context("when the deployment is filtered", func() {
+			ginkgo.It("returns true", func() {
+				gomega.Expect(deploymentsFilter.Enabled("fake-deployment-name-1")).To(gomega.BeTrue())
+			})
+		})
+
+		ginkgo.Context("when the deployment is not filtered", func() {
+			ginkgo.It("returns false", func() {
+				gomega.Expect(deploymentsFilter.Enabled("fake-deployment-name-2")).To(gomega.BeFalse())
+			})
+		})
+
+		ginkgo.Context("when there are no filters", func() {
+			ginkgo.BeforeEach(func() {
+				filtersArray = []string{}
+			})
+
+			ginkgo.It("returns true", func() {
+				gomega.Expect(deploymentsFilter.Enabled("fake-deployment-name-2")).To(gomega.BeTrue())
+			})
+		})
+
+		ginkgo.Context("when the deployment name has leading and/or trailing whitespaces", func() {
+			ginkgo.BeforeEach(func() {
+				filtersArray = []string{"   fake-deployment-name-1  "}
+			})
+
+			ginkgo.It("returns true", func() {
+				gomega.Expect(deploymentsFilter.Enabled("fake-deployment-name-1")).To(gomega.BeTrue())
+			})
+		})
+	})
 })
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/config"
+)
+
+const defaultProbeModule = "default"
+
+type probeTargetKey struct {
+	target string
+	module string
+}
+
+// probeTarget holds the collector of a probed BOSH Director. Its client is built in the background on the first probe,
+// so that a BOSH Director slower to answer than the scrape timeout is still probed once it has: the probes wait for the
+// built channel to be closed, or give up when their scrape times out. The proxy of the client is released once the
+// target is dropped, which cancels its ctx.
+type probeTarget struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	built         chan struct{}
+	boshCollector *collectors.BoshCollector
+	err           error
+	lastProbed    time.Time
+}
+
+// probeHandler serves the metrics of the BOSH Director set in the `target` query parameter, using the credentials
+// and filters of the probe module set in the `module` query parameter. The collectors of the targets not probed for
+// clientTTL are dropped.
+type probeHandler struct {
+	modules   map[string]config.Director
+	clientTTL time.Duration
+	ctx       context.Context
+	cancel    context.CancelFunc
+	mu        sync.Mutex
+	targets   map[probeTargetKey]*probeTarget
+}
+
+func newProbeHandler(modules map[string]config.Director, clientTTL time.Duration) *probeHandler {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &probeHandler{
+		modules:   modules,
+		clientTTL: clientTTL,
+		ctx:       ctx,
+		cancel:    cancel,
+		targets:   map[probeTargetKey]*probeTarget{},
+	}
+}
+
+// close drops the collectors of every target, once the handler is replaced on reload.
+func (h *probeHandler) close() {
+	h.cancel()
+}
+
+func (h *probeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var begun = time.Now()
+
+	target := r.URL.Query().Get("target")
+	if target == "" {
+		http.Error(w, "Target parameter is missing", http.StatusBadRequest)
+		return
+	}
+
+	moduleName := r.URL.Query().Get("module")
+	if moduleName == "" {
+		moduleName = defaultProbeModule
+	}
+	module, ok := h.modules[moduleName]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown module `%s`", moduleName), http.StatusBadRequest)
+		return
+	}
+
+	// the module credentials are only sent to the targets it allows, not to any URL set by the caller
+	if !module.AllowsTarget(target) {
+		http.Error(w, fmt.Sprintf("Target `%s` is not allowed by module `%s`", target, moduleName), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := scrapeContext(r)
+	defer cancel()
+
+	collector := &probeCollector{
+		ctx:   ctx,
+		begun: begun,
+		probeSuccessDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(*metricsNamespace, "", "probe_success"),
+			"Whether the BOSH Director could be scraped without errors (1 for success, 0 for failure).",
+			nil,
+			nil,
+		),
+		probeDurationSecondsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(*metricsNamespace, "", "probe_duration_seconds"),
+			"Duration of the probe in seconds.",
+			nil,
+			nil,
+		),
+	}
+
+	boshCollector, err := h.boshCollector(ctx, target, moduleName, module)
+	if err != nil {
+		log.Errorf("Error probing BOSH Director `%s` with module `%s`: %v", target, moduleName, err)
+	} else {
+		collector.boshCollector = boshCollector
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
+
+// probeCollector collects the metrics of a probed BOSH Director within the ctx of the probe, followed by the
+// probe_success and probe_duration_seconds metrics, which are only known once the BOSH Director was scraped. Without a
+// boshCollector, i.e. when its client could not be created, the probe fails.
+type probeCollector struct {
+	ctx                      context.Context
+	begun                    time.Time
+	boshCollector            *collectors.BoshCollector
+	probeSuccessDesc         *prometheus.Desc
+	probeDurationSecondsDesc *prometheus.Desc
+}
+
+func (c *probeCollector) Describe(ch chan<- *prometheus.Desc) {
+	if c.boshCollector != nil {
+		c.boshCollector.Describe(ch)
+	}
+	ch <- c.probeSuccessDesc
+	ch <- c.probeDurationSecondsDesc
+}
+
+func (c *probeCollector) Collect(ch chan<- prometheus.Metric) {
+	probeSuccess := 0.0
+	if c.boshCollector != nil {
+		if err := c.boshCollector.CollectWithContext(c.ctx, ch); err == nil {
+			probeSuccess = 1
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.probeSuccessDesc, prometheus.GaugeValue, probeSuccess)
+	ch <- prometheus.MustNewConstMetric(c.probeDurationSecondsDesc, prometheus.GaugeValue, time.Since(c.begun).Seconds())
+}
+
+// boshCollector returns the cached collector of a target, whose client is built the first time the target is probed
+// with the module. When ctx is done first, the probe gives up, and the build carries on in the background, so that a
+// later probe gets its result. A failed build is not cached, so the next probe tries again.
+func (h *probeHandler) boshCollector(
+	ctx context.Context,
+	target string,
+	moduleName string,
+	module config.Director,
+) (*collectors.BoshCollector, error) {
+	module.URL = target
+	if module.Environment == "" {
+		module.Environment = target
+	}
+
+	t := h.probeTarget(probeTargetKey{target: target, module: moduleName}, module)
+
+	select {
+	case <-t.built:
+		return t.boshCollector, t.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out waiting for the BOSH client to be created: %w", ctx.Err())
+	}
+}
+
+// probeTarget returns the target of key, after dropping the targets not probed for clientTTL. The client of a new
+// target is built in the background with module.
+func (h *probeHandler) probeTarget(key probeTargetKey, module config.Director) *probeTarget {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for k, t := range h.targets {
+		if now.Sub(t.lastProbed) > h.clientTTL {
+			delete(h.targets, k)
+			t.cancel()
+		}
+	}
+
+	t, ok := h.targets[key]
+	if !ok {
+		t = &probeTarget{built: make(chan struct{})}
+		t.ctx, t.cancel = context.WithCancel(h.ctx)
+		h.targets[key] = t
+		go h.build(key, t, module)
+	}
+	t.lastProbed = now
+
+	return t
+}
+
+// build builds the client of target t, and drops t if it fails, so that failed targets are not kept.
+func (h *probeHandler) build(key probeTargetKey, t *probeTarget, module config.Director) {
+	t.boshCollector, t.err = newBoshCollector(t.ctx, module, 0)
+	close(t.built)
+	if t.err == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.targets[key] == t {
+		delete(h.targets, key)
+	}
+	t.cancel()
+}
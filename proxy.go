@@ -0,0 +1,73 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/bosh-utils/httpclient"
+	goproxy "golang.org/x/net/proxy"
+
+	"github.com/cloudfoundry/bosh_exporter/tunnel"
+)
+
+// sshTunnels holds the SSH tunnels of the ssh+socks5:// proxies, shared by the BOSH Directors behind the same jumpbox.
+var sshTunnels *tunnel.Pool
+
+// proxyDialContext returns the dialer of the HTTP clients of a BOSH Director reached through allProxy, which takes
+// the same values as the bosh CLI BOSH_ALL_PROXY environment variable, and the function releasing it once the clients
+// are not used anymore. An ssh+socks5:// proxy dials through its tunnel, which reconnects to the jumpbox when the SSH
+// connection is lost. When allProxy is not set, the dialer is nil, so that the BOSH_ALL_PROXY environment variable of
+// the exporter, if any, is used as is.
+func proxyDialContext(allProxy string) (httpclient.DialContextFunc, func(), error) {
+	if allProxy == "" {
+		return nil, func() {}, nil
+	}
+
+	if strings.HasPrefix(allProxy, tunnel.Scheme+"://") {
+		t, err := sshTunnels.Tunnel(allProxy)
+		if err != nil {
+			return nil, nil, err
+		}
+		dialContext := func(_ context.Context, network string, address string) (net.Conn, error) {
+			return t.Dial(network, address)
+		}
+		return dialContext, func() { sshTunnels.Release(t) }, nil
+	}
+
+	proxyURL, err := url.Parse(allProxy)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid proxy URL: %v", err)
+	}
+
+	// as with the bosh CLI, the hosts listed in NO_PROXY are dialed directly
+	direct := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	dialer, err := goproxy.FromURL(proxyURL, direct)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid proxy URL `%s`: %v", proxyURL.Redacted(), err)
+	}
+	perHost := goproxy.NewPerHost(dialer, direct)
+	if noProxy := cmp.Or(os.Getenv("NO_PROXY"), os.Getenv("no_proxy")); noProxy != "" {
+		perHost.AddFromString(noProxy)
+	}
+
+	return perHost.DialContext, func() {}, nil
+}
+
+// newHTTPClient returns the HTTP client of the BOSH clients of a BOSH Director reached through a proxy, which trusts
+// certPool and dials with dialContext. The proxy of the BOSH Director replaces the HTTP(S)_PROXY environment variables,
+// which would otherwise be used for its requests.
+func newHTTPClient(certPool *x509.CertPool, dialContext httpclient.DialContextFunc) *http.Client {
+	client := httpclient.CreateDefaultClient(certPool)
+	transport := client.Transport.(*http.Transport)
+	transport.Proxy = nil
+	transport.DialContext = dialContext
+	return client
+}
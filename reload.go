@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/config"
+)
+
+// monitoredDirector is a BOSH Director monitored by the exporter, whose deployments may be fetched in the background
+// until it is removed from the configuration. Its collector is only set once the BOSH Director could be reached.
+type monitoredDirector struct {
+	config        config.Director
+	boshCollector atomic.Pointer[collectors.BoshCollector]
+	cancel        context.CancelFunc
+}
+
+// connect builds the collector of the BOSH Director, retrying with an exponential backoff, from minBackoff up to
+// maxBackoff, until the BOSH Director can be reached or ctx is done.
+func (d *monitoredDirector) connect(ctx context.Context, minBackoff time.Duration, maxBackoff time.Duration) {
+	backoff := minBackoff
+	for {
+		boshCollector, err := newBoshCollector(ctx, d.config, *deploymentsRefreshInterval)
+		if err == nil {
+			d.boshCollector.Store(boshCollector)
+			return
+		}
+		log.Errorf("Error connecting to BOSH Director `%s`, retrying in %s: %v", d.config.URL, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// exporterState holds everything built from the configuration, which is replaced as a whole when it is reloaded.
+type exporterState struct {
+	directors    []*monitoredDirector
+	probeHandler *probeHandler
+
+	// secrets holds the content of every secret file read to build the state, to tell when one of them changed
+	secrets map[string]string
+}
+
+// reloader builds the exporter state from the configuration, and rebuilds it on demand without dropping the HTTP
+// server. The BOSH Directors and probe modules whose settings did not change are kept as they are, so that their
+// clients and caches survive the reload.
+type reloader struct {
+	mu                               sync.Mutex
+	state                            atomic.Pointer[exporterState]
+	lastReloadSuccessfulMetric       prometheus.Gauge
+	lastReloadSuccessTimestampMetric prometheus.Gauge
+	directorUpDesc                   *prometheus.Desc
+}
+
+func newReloader(namespace string) *reloader {
+	r := &reloader{
+		lastReloadSuccessfulMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "exporter",
+			Name:      "config_last_reload_successful",
+			Help:      "Whether the last configuration reload attempt was successful (1 for success, 0 for failure).",
+		}),
+		lastReloadSuccessTimestampMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "exporter",
+			Name:      "config_last_reload_success_timestamp_seconds",
+			Help:      "Timestamp of the last successful configuration reload.",
+		}),
+		directorUpDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "director_up"),
+			"Whether the BOSH Director answered the last time its deployments were fetched (1 for up, 0 for down or while retrying to connect).",
+			[]string{"environment", "bosh_url"},
+			nil,
+		),
+	}
+	r.state.Store(&exporterState{})
+
+	return r
+}
+
+func (r *reloader) Describe(ch chan<- *prometheus.Desc) {
+	r.lastReloadSuccessfulMetric.Describe(ch)
+	r.lastReloadSuccessTimestampMetric.Describe(ch)
+	ch <- r.directorUpDesc
+}
+
+func (r *reloader) Collect(ch chan<- prometheus.Metric) {
+	r.lastReloadSuccessfulMetric.Collect(ch)
+	r.lastReloadSuccessTimestampMetric.Collect(ch)
+
+	for _, director := range r.state.Load().directors {
+		up := 0.0
+		if boshCollector := director.boshCollector.Load(); boshCollector != nil && boshCollector.DirectorReachable() {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(
+			r.directorUpDesc,
+			prometheus.GaugeValue,
+			up,
+			director.config.Environment,
+			director.config.URL,
+		)
+	}
+}
+
+// boshCollectors returns the collectors of the BOSH Directors currently monitored which could be reached.
+func (r *reloader) boshCollectors() []*collectors.BoshCollector {
+	directors := r.state.Load().directors
+
+	boshCollectors := make([]*collectors.BoshCollector, 0, len(directors))
+	for _, director := range directors {
+		if boshCollector := director.boshCollector.Load(); boshCollector != nil {
+			boshCollectors = append(boshCollectors, boshCollector)
+		}
+	}
+
+	return boshCollectors
+}
+
+// probeHandler returns the handler of the probe endpoint, or nil when no probe module is configured.
+func (r *reloader) probeHandler() *probeHandler {
+	return r.state.Load().probeHandler
+}
+
+// ServeHTTP reloads the configuration on a POST request.
+func (r *reloader) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Only POST requests allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := r.reload(); err != nil {
+		log.Errorf("Error reloading configuration: %v", err)
+		http.Error(w, fmt.Sprintf("Failed to reload configuration: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// reloadOnSignal reloads the configuration every time the process receives sig.
+func (r *reloader) reloadOnSignal(sig os.Signal) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, sig)
+
+	for range signals {
+		if err := r.reload(); err != nil {
+			log.Errorf("Error reloading configuration: %v", err)
+		}
+	}
+}
+
+// reloadOnSecretChange checks the secret files every interval, and reloads the configuration when one of them changed,
+// so that the UAA sessions using a rotated secret are rebuilt.
+func (r *reloader) reloadOnSecretChange(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if !r.state.Load().secretsChanged() {
+			continue
+		}
+
+		log.Infoln("Secret files changed, reloading configuration")
+		if err := r.reload(); err != nil {
+			log.Errorf("Error reloading configuration: %v", err)
+		}
+	}
+}
+
+// reload reads the configuration again and rebuilds the exporter state. On failure, the current state is kept.
+func (r *reloader) reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	log.Infoln("Loading configuration")
+
+	if err := r.apply(); err != nil {
+		r.lastReloadSuccessfulMetric.Set(0)
+		return err
+	}
+
+	r.lastReloadSuccessfulMetric.Set(1)
+	r.lastReloadSuccessTimestampMetric.SetToCurrentTime()
+
+	return nil
+}
+
+func (r *reloader) apply() error {
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	directorsConfig, err := directorsConfig(file)
+	if err != nil {
+		return err
+	}
+
+	modules, err := modulesConfig(file)
+	if err != nil {
+		return err
+	}
+
+	// the content of the secret files is recorded before they are read into the settings, so that a file changing in
+	// between is read again on the next check
+	secrets := map[string]string{}
+	for i, directorConfig := range directorsConfig {
+		if directorsConfig[i], err = readSecrets(directorConfig, secrets); err != nil {
+			return err
+		}
+	}
+	for name, module := range modules {
+		if modules[name], err = readSecrets(module, secrets); err != nil {
+			return err
+		}
+	}
+
+	current := r.state.Load()
+
+	// a BOSH Director is connected to in the background, so that one that cannot be reached yet does not prevent
+	// monitoring the other ones, nor serving the exporter's own metrics
+	var directors, started []*monitoredDirector
+	for _, directorConfig := range directorsConfig {
+		if director := current.monitoredDirector(directorConfig); director != nil {
+			directors = append(directors, director)
+			continue
+		}
+
+		director := &monitoredDirector{config: directorConfig}
+		if *deploymentsSourceFile != "" {
+			boshCollector, err := newSnapshotBoshCollector(*deploymentsSourceFile, directorConfig)
+			if err != nil {
+				return err
+			}
+			director.boshCollector.Store(boshCollector)
+		}
+		directors = append(directors, director)
+		started = append(started, director)
+	}
+
+	probeHandler := current.probeHandler
+	if probeHandler == nil || !reflect.DeepEqual(probeHandler.modules, modules) {
+		probeHandler = nil
+		if len(modules) > 0 {
+			probeHandler = newProbeHandler(modules, *probeClientTTL)
+		}
+	}
+
+	for _, director := range started {
+		var ctx context.Context
+		ctx, director.cancel = context.WithCancel(context.Background())
+		if director.boshCollector.Load() == nil {
+			go director.connect(ctx, *boshConnectMinBackoff, *boshConnectMaxBackoff)
+		}
+	}
+
+	r.state.Store(&exporterState{
+		directors:    directors,
+		probeHandler: probeHandler,
+		secrets:      secrets,
+	})
+
+	// the proxies of the BOSH Directors and probe targets dropped are released
+	for _, director := range current.directors {
+		if !slices.Contains(directors, director) {
+			director.cancel()
+		}
+	}
+	if current.probeHandler != nil && current.probeHandler != probeHandler {
+		current.probeHandler.close()
+	}
+
+	log.Infof("Monitoring %d BOSH Director(s), %d started, with %d probe module(s)", len(directors), len(started), len(modules))
+
+	return nil
+}
+
+// monitoredDirector returns the BOSH Director currently monitored with exactly the same settings, if any.
+func (s *exporterState) monitoredDirector(directorConfig config.Director) *monitoredDirector {
+	for _, director := range s.directors {
+		if reflect.DeepEqual(director.config, directorConfig) {
+			return director
+		}
+	}
+	return nil
+}
+
+// secretsChanged tells whether one of the secret files read to build the state changed. A file that cannot be read,
+// for instance while it is being replaced, is checked again later.
+func (s *exporterState) secretsChanged() bool {
+	for filename, secret := range s.secrets {
+		if current, err := config.ReadSecretFile(filename); err == nil && current != secret {
+			return true
+		}
+	}
+	return false
+}
+
+// readSecrets returns directorConfig with the secrets read from their files, whose content is recorded into secrets.
+func readSecrets(directorConfig config.Director, secrets map[string]string) (config.Director, error) {
+	for _, filename := range directorConfig.SecretFiles() {
+		secret, err := config.ReadSecretFile(filename)
+		if err != nil {
+			return directorConfig, err
+		}
+		secrets[filename] = secret
+	}
+
+	return directorConfig.WithSecrets()
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cloudfoundry/bosh_exporter/collectors"
+	"github.com/cloudfoundry/bosh_exporter/config"
+	"github.com/cloudfoundry/bosh_exporter/deployments"
+	"github.com/cloudfoundry/bosh_exporter/filters"
+)
+
+// snapshotCollectors are the collectors that only report on the deployments, and so can be served from a deployments
+// snapshot instead of a live BOSH Director.
+var snapshotCollectors = []string{
+	filters.DeploymentsCollector,
+	filters.JobsCollector,
+	filters.ServiceDiscoveryCollector,
+}
+
+// dumpDeployments reads the deployments of the configured BOSH Director and records them into filename, in the format
+// replayed with the deployments.source-file flag.
+func dumpDeployments(filename string) error {
+	file, err := loadConfigFile()
+	if err != nil {
+		return err
+	}
+
+	directors, err := directorsConfig(file)
+	if err != nil {
+		return err
+	}
+	if len(directors) != 1 {
+		return errors.New("exactly one BOSH Director must be configured to dump its deployments")
+	}
+
+	directorConfig, err := directors[0].WithSecrets()
+	if err != nil {
+		return err
+	}
+
+	boshClient, _, err := buildBOSHClient(context.Background(), directorConfig)
+	if err != nil {
+		return fmt.Errorf("error creating BOSH Client: %v", err)
+	}
+
+	deploymentsFilter := filters.NewDeploymentsFilter(directors[0].Filters.Deployments, boshClient)
+	deploymentsFetcher := deployments.NewFetcher(*deploymentsFilter, *deploymentsFetchWorkers)
+	deploymentsInfo, err := deploymentsFetcher.Deployments(context.Background())
+	if err != nil {
+		return err
+	}
+
+	// the fetcher leaves out the deployments it failed to read, which would silently be missing from the snapshot
+	var failedDeployments []string
+	for deploymentName, status := range deploymentsFetcher.DeploymentsFetchStatus() {
+		if !status.Success {
+			failedDeployments = append(failedDeployments, fmt.Sprintf("`%s`", deploymentName))
+		}
+	}
+	if len(failedDeployments) > 0 {
+		slices.Sort(failedDeployments)
+		return fmt.Errorf("error reading deployments %s, not writing an incomplete snapshot", strings.Join(failedDeployments, ", "))
+	}
+
+	if err := deployments.WriteSnapshotFile(filename, deploymentsInfo); err != nil {
+		return err
+	}
+	log.Infof("Wrote %d deployments of BOSH Director `%s` to `%s`", len(deploymentsInfo), directors[0].URL, filename)
+
+	return nil
+}
+
+// newSnapshotBoshCollector builds the collector of the deployments recorded into filename. As there is no BOSH Director
+// to read its name and UUID from, the bosh_name and bosh_uuid labels are empty, and only the collectors reporting on
+// the deployments can be enabled.
+func newSnapshotBoshCollector(filename string, directorConfig config.Director) (*collectors.BoshCollector, error) {
+	if len(directorConfig.Filters.Collectors) == 0 {
+		directorConfig.Filters.Collectors = snapshotCollectors
+	}
+	for _, collector := range directorConfig.Filters.Collectors {
+		if !slices.Contains(snapshotCollectors, strings.TrimSpace(collector)) {
+			return nil, fmt.Errorf("the %s collector cannot be served from a deployments snapshot", collector)
+		}
+	}
+
+	deploymentsSource, err := deployments.NewSnapshotSource(filename, *filters.NewDeploymentsFilter(directorConfig.Filters.Deployments, nil))
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Using deployments snapshot `%s` for environment `%s`", filename, directorConfig.Environment)
+
+	return buildBoshCollector(directorConfig, "", "", deploymentsSource, nil, nil)
+}
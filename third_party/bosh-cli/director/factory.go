@@ -0,0 +1,117 @@
+package director
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cloudfoundry/bosh-utils/httpclient"
+
+	bosherr "github.com/cloudfoundry/bosh-utils/errors"
+	boshlog "github.com/cloudfoundry/bosh-utils/logger"
+)
+
+type Factory struct {
+	logTag string
+	logger boshlog.Logger
+}
+
+func NewFactory(logger boshlog.Logger) Factory {
+	return Factory{
+		logTag: "director.Factory",
+		logger: logger,
+	}
+}
+
+func (f Factory) New(factoryConfig FactoryConfig, taskReporter TaskReporter, fileReporter FileReporter) (Director, error) {
+	err := factoryConfig.Validate()
+	if err != nil {
+		return DirectorImpl{}, bosherr.WrapErrorf(
+			err, "Validating Director connection config")
+	}
+
+	certPool, err := factoryConfig.CACertPool()
+	if err != nil {
+		return DirectorImpl{}, err
+	}
+
+	if certPool == nil {
+		f.logger.Debug(f.logTag, "Using default root CAs")
+	} else {
+		f.logger.Debug(f.logTag, "Using custom root CAs")
+	}
+
+	client := f.httpClient(factoryConfig, httpclient.CreateDefaultClient(certPool), taskReporter, fileReporter)
+
+	return DirectorImpl{client: client}, nil
+}
+
+// NewWithHTTPClient returns a Director sending its requests with rawClient, instead of a default client trusting the
+// CA certificate of factoryConfig, e.g. to dial the Director through its own proxy. The authentication, redirects and
+// retries are handled as with New.
+func (f Factory) NewWithHTTPClient(factoryConfig FactoryConfig, rawClient *http.Client, taskReporter TaskReporter, fileReporter FileReporter) (Director, error) {
+	err := factoryConfig.Validate()
+	if err != nil {
+		return DirectorImpl{}, bosherr.WrapErrorf(
+			err, "Validating Director connection config")
+	}
+
+	client := f.httpClient(factoryConfig, rawClient, taskReporter, fileReporter)
+
+	return DirectorImpl{client: client}, nil
+}
+
+func (f Factory) httpClient(factoryConfig FactoryConfig, rawClient *http.Client, taskReporter TaskReporter, fileReporter FileReporter) Client {
+	authAdjustment := NewAuthRequestAdjustment(
+		factoryConfig.TokenFunc,
+		factoryConfig.Client,
+		factoryConfig.ClientSecret,
+	)
+	rawClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > 10 {
+			return bosherr.Error("Too many redirects")
+		}
+
+		// Since redirected requests are not retried,
+		// forcefully adjust auth token as this is the last chance.
+		err := authAdjustment.Adjust(req, true)
+		if err != nil {
+			return err
+		}
+
+		req.URL.Host = net.JoinHostPort(factoryConfig.Host, fmt.Sprintf("%d", factoryConfig.Port))
+
+		clearHeaders(req)
+		clearBody(req)
+
+		return nil
+	}
+
+	retryClient := httpclient.NewNetworkSafeRetryClient(rawClient, 5, 500*time.Millisecond, f.logger)
+
+	authedClient := NewAdjustableClient(retryClient, authAdjustment)
+
+	httpOpts := httpclient.Opts{NoRedactUrlQuery: true}
+	httpClient := httpclient.NewHTTPClientOpts(authedClient, f.logger, httpOpts)
+
+	endpoint := url.URL{
+		Scheme: "https",
+		Host:   net.JoinHostPort(factoryConfig.Host, fmt.Sprintf("%d", factoryConfig.Port)),
+	}
+
+	return NewClient(endpoint.String(), httpClient, taskReporter, fileReporter, f.logger)
+}
+
+func clearBody(req *http.Request) {
+	req.Body = nil
+}
+
+func clearHeaders(req *http.Request) {
+	authValue := req.Header.Get("Authorization")
+	req.Header = make(map[string][]string)
+	if authValue != "" {
+		req.Header.Add("Authorization", authValue)
+	}
+}
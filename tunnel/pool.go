@@ -0,0 +1,102 @@
+package tunnel
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Pool holds the tunnels of the proxy URLs in use, so that the BOSH Directors behind the same jumpbox share their SSH
+// connection. A tunnel is closed once every user released it. It exports the state of the tunnels as metrics.
+type Pool struct {
+	mu      sync.Mutex
+	tunnels map[string]*Tunnel
+	users   map[*Tunnel]int
+
+	upDesc         *prometheus.Desc
+	reconnectsDesc *prometheus.Desc
+}
+
+// NewPool returns an empty Pool, whose metrics are prefixed with namespace.
+func NewPool(namespace string) *Pool {
+	return &Pool{
+		tunnels: map[string]*Tunnel{},
+		users:   map[*Tunnel]int{},
+		upDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "ssh_tunnel", "up"),
+			"Whether the SSH connection of the proxy is established (1 for up, 0 for down).",
+			[]string{"proxy"},
+			nil,
+		),
+		reconnectsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "ssh_tunnel", "reconnects_total"),
+			"Total number of times the SSH connection of the proxy was re-established after it was lost.",
+			[]string{"proxy"},
+			nil,
+		),
+	}
+}
+
+// Tunnel returns the Tunnel of proxyURL, starting it on first use. A tunnel is shared by the proxy URLs which only
+// differ by their private key file, the last one being used for the next SSH connection. Every call must be matched
+// by a call to Release once the tunnel is not used anymore.
+func (p *Pool) Tunnel(proxyURL string) (*Tunnel, error) {
+	parsed, err := parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t, ok := p.tunnels[parsed.name]; ok {
+		t.setPrivateKeyFile(parsed.privateKeyFile)
+		p.users[t]++
+		return t, nil
+	}
+
+	t, err := New(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	p.tunnels[t.name] = t
+	p.users[t] = 1
+
+	return t, nil
+}
+
+// Release tells that a user of the Tunnel returned by Tunnel does not use it anymore. The tunnel is closed and dropped
+// once it has no user left.
+func (p *Pool) Release(t *Tunnel) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.users[t]--; p.users[t] > 0 {
+		return
+	}
+
+	delete(p.users, t)
+	if p.tunnels[t.name] == t {
+		delete(p.tunnels, t.name)
+	}
+	t.Close()
+}
+
+func (p *Pool) Describe(ch chan<- *prometheus.Desc) {
+	ch <- p.upDesc
+	ch <- p.reconnectsDesc
+}
+
+func (p *Pool) Collect(ch chan<- prometheus.Metric) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for name, t := range p.tunnels {
+		up := 0.0
+		if t.Up() {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(p.upDesc, prometheus.GaugeValue, up, name)
+		ch <- prometheus.MustNewConstMetric(p.reconnectsDesc, prometheus.CounterValue, float64(t.Reconnects()), name)
+	}
+}
@@ -0,0 +1,225 @@
+// Package tunnel dials connections through SSH servers, as the bosh CLI does with a BOSH_ALL_PROXY=ssh+socks5://
+// proxy. Unlike the bosh CLI, the SSH connection is re-established when it is lost.
+package tunnel
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	proxy "github.com/cloudfoundry/socks5-proxy"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// Scheme is the scheme of the proxy URLs tunneled through SSH.
+	Scheme = "ssh+socks5"
+
+	defaultUsername   = "jumpbox"
+	keepAliveRequest  = "bosh-cli-keep-alive@bosh.io"
+	keepAliveInterval = 30 * time.Second
+)
+
+// Tunnel is an SSH connection to a jumpbox, through which connections are dialed. The SSH connection is established
+// on the first dial, and re-established when it is lost, until the Tunnel is closed.
+type Tunnel struct {
+	name     string
+	host     string
+	username string
+
+	mu             sync.Mutex
+	privateKeyFile string
+	hostKey        ssh.PublicKey
+	client         *ssh.Client
+	connected      bool
+	closed         bool
+
+	up         atomic.Bool
+	reconnects atomic.Uint64
+}
+
+// New returns the Tunnel of a ssh+socks5://[user@]host:port?private-key=file proxy URL. The private key is read every
+// time the SSH connection is established, so that it can be rotated.
+func New(proxyURL string) (*Tunnel, error) {
+	return parse(proxyURL)
+}
+
+func parse(proxyURL string) (*Tunnel, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %v", err)
+	}
+	if u.Scheme != Scheme {
+		return nil, fmt.Errorf("invalid proxy URL `%s`: the scheme must be %s", u.Redacted(), Scheme)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("invalid proxy URL `%s`: the port is missing", u.Redacted())
+	}
+
+	privateKeyFile := u.Query().Get("private-key")
+	if privateKeyFile == "" {
+		return nil, fmt.Errorf("invalid proxy URL `%s`: the private-key query parameter is missing", u.Redacted())
+	}
+
+	username := u.User.Username()
+	if username == "" {
+		username = defaultUsername
+	}
+
+	return &Tunnel{
+		name:           fmt.Sprintf("%s://%s@%s", Scheme, username, u.Host),
+		host:           u.Host,
+		username:       username,
+		privateKeyFile: privateKeyFile,
+	}, nil
+}
+
+// Name returns the proxy URL of the Tunnel, without its private key file.
+func (t *Tunnel) Name() string {
+	return t.name
+}
+
+// Up returns whether the SSH connection is established.
+func (t *Tunnel) Up() bool {
+	return t.up.Load()
+}
+
+// Reconnects returns the number of times the SSH connection was re-established after it was lost.
+func (t *Tunnel) Reconnects() uint64 {
+	return t.reconnects.Load()
+}
+
+// Dial connects to address through the SSH server. When the SSH connection turns out to be lost, the dial is retried
+// once over a new one.
+func (t *Tunnel) Dial(network string, address string) (net.Conn, error) {
+	client, err := t.sshClient()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := client.Dial(network, address)
+	if err == nil {
+		return conn, nil
+	}
+	if _, _, keepAliveErr := client.SendRequest(keepAliveRequest, true, nil); keepAliveErr == nil {
+		return nil, err
+	}
+
+	log.Warnf("Lost SSH connection to `%s`, reconnecting: %v", t.name, err)
+	t.disconnect(client)
+
+	if client, err = t.sshClient(); err != nil {
+		return nil, err
+	}
+
+	return client.Dial(network, address)
+}
+
+// Close closes the SSH connection, after which dials fail.
+func (t *Tunnel) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+	if t.client != nil {
+		_ = t.client.Close()
+		t.client = nil
+		t.up.Store(false)
+	}
+}
+
+func (t *Tunnel) setPrivateKeyFile(privateKeyFile string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.privateKeyFile = privateKeyFile
+}
+
+func (t *Tunnel) sshClient() (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil, fmt.Errorf("tunnel `%s` is closed", t.name)
+	}
+	if t.client != nil {
+		return t.client, nil
+	}
+
+	privateKey, err := os.ReadFile(t.privateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading private key file of `%s`: %v", t.name, err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key file of `%s`: %v", t.name, err)
+	}
+
+	// as with the bosh CLI, the host key is trusted on first use, then pinned for the reconnections
+	if t.hostKey == nil {
+		if t.hostKey, err = proxy.NewHostKey().Get(t.username, string(privateKey), t.host); err != nil {
+			return nil, fmt.Errorf("error getting host key of `%s`: %v", t.name, err)
+		}
+	}
+
+	clientConfig := proxy.NewSSHClientConfig(t.username, ssh.FixedHostKey(t.hostKey), ssh.PublicKeys(signer))
+	client, err := ssh.Dial("tcp", t.host, clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to `%s`: %v", t.name, err)
+	}
+
+	if t.connected {
+		t.reconnects.Add(1)
+		log.Infof("Reconnected to `%s`", t.name)
+	}
+	t.connected = true
+	t.client = client
+	t.up.Store(true)
+
+	go t.keepAlive(client)
+
+	return client, nil
+}
+
+// keepAlive sends keep-alive requests over the SSH connection until it is closed, so that a lost connection is noticed
+// before the next dial.
+func (t *Tunnel) keepAlive(client *ssh.Client) {
+	closed := make(chan struct{})
+	go func() {
+		_ = client.Wait()
+		close(closed)
+	}()
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			t.disconnect(client)
+			return
+		case <-ticker.C:
+			if _, _, err := client.SendRequest(keepAliveRequest, true, nil); err != nil {
+				log.Warnf("Error sending SSH keep-alive to `%s`: %v", t.name, err)
+				t.disconnect(client)
+			}
+		}
+	}
+}
+
+func (t *Tunnel) disconnect(client *ssh.Client) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.client == client {
+		t.client = nil
+		t.up.Store(false)
+	}
+	_ = client.Close()
+}
@@ -0,0 +1,13 @@
+package tunnel_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTunnel(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Tunnel Suite")
+}
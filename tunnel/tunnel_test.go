@@ -0,0 +1,208 @@
+package tunnel_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cloudfoundry/bosh_exporter/tunnel"
+	"github.com/cloudfoundry/bosh_exporter/utils/fakebosh"
+	"github.com/cloudfoundry/bosh_exporter/utils/matchers"
+)
+
+var _ = ginkgo.Describe("Pool", func() {
+	var (
+		err            error
+		tmpDir         string
+		privateKeyFile string
+		jumpbox        *fakebosh.Jumpbox
+		server         *httptest.Server
+		pool           *tunnel.Pool
+		proxyURL       string
+	)
+
+	ginkgo.BeforeEach(func() {
+		tmpDir, err = os.MkdirTemp("", "tunnel_test_")
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+		jumpbox = fakebosh.NewJumpbox()
+		privateKeyFile = filepath.Join(tmpDir, "jumpbox.key")
+		gomega.Expect(os.WriteFile(privateKeyFile, jumpbox.PrivateKey(), 0600)).To(gomega.Succeed())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte("fake-response"))
+		}))
+
+		pool = tunnel.NewPool("test_exporter")
+		proxyURL = fmt.Sprintf("ssh+socks5://%s@%s?private-key=%s", fakebosh.JumpboxUsername, jumpbox.Addr(), privateKeyFile)
+	})
+
+	ginkgo.AfterEach(func() {
+		server.Close()
+		jumpbox.Close()
+		gomega.Expect(os.RemoveAll(tmpDir)).To(gomega.Succeed())
+	})
+
+	get := func(t *tunnel.Tunnel) (string, error) {
+		client := &http.Client{Transport: &http.Transport{Dial: t.Dial, DisableKeepAlives: true}}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	ginkgo.It("connects through the jumpbox", func() {
+		t, err := pool.Tunnel(proxyURL)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(t.Up()).To(gomega.BeFalse())
+
+		gomega.Expect(get(t)).To(gomega.Equal("fake-response"))
+		gomega.Expect(jumpbox.Forwarded()).To(gomega.Equal(1))
+		gomega.Expect(t.Up()).To(gomega.BeTrue())
+		gomega.Expect(t.Reconnects()).To(gomega.BeZero())
+	})
+
+	ginkgo.It("shares the SSH connection of the same proxy", func() {
+		t1, err := pool.Tunnel(proxyURL)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		t2, err := pool.Tunnel(proxyURL)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(t2).To(gomega.BeIdenticalTo(t1))
+
+		gomega.Expect(get(t1)).To(gomega.Equal("fake-response"))
+		gomega.Expect(get(t2)).To(gomega.Equal("fake-response"))
+		// one connection to scan the host key, and one to dial through
+		gomega.Expect(jumpbox.Connections()).To(gomega.Equal(2))
+	})
+
+	ginkgo.Context("when the tunnel is released", func() {
+		ginkgo.It("keeps it open while it has other users", func() {
+			t1, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			t2, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(get(t1)).To(gomega.Equal("fake-response"))
+
+			pool.Release(t1)
+
+			gomega.Expect(t2.Up()).To(gomega.BeTrue())
+			gomega.Expect(get(t2)).To(gomega.Equal("fake-response"))
+		})
+
+		ginkgo.It("closes it once it has no user left", func() {
+			t, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(get(t)).To(gomega.Equal("fake-response"))
+
+			pool.Release(t)
+
+			gomega.Expect(t.Up()).To(gomega.BeFalse())
+			_, err = get(t)
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("is closed")))
+
+			metrics := make(chan prometheus.Metric, 2)
+			pool.Collect(metrics)
+			gomega.Expect(metrics).ToNot(gomega.Receive())
+
+			newTunnel, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(newTunnel).ToNot(gomega.BeIdenticalTo(t))
+			gomega.Expect(get(newTunnel)).To(gomega.Equal("fake-response"))
+		})
+	})
+
+	ginkgo.It("names the tunnel after the proxy URL, without its private key", func() {
+		t, err := pool.Tunnel(fmt.Sprintf("ssh+socks5://%s?private-key=%s", jumpbox.Addr(), privateKeyFile))
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(t.Name()).To(gomega.Equal("ssh+socks5://jumpbox@" + jumpbox.Addr()))
+	})
+
+	ginkgo.Context("when the SSH connection is lost", func() {
+		ginkgo.It("reconnects", func() {
+			t, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(get(t)).To(gomega.Equal("fake-response"))
+
+			jumpbox.DropConnections()
+			gomega.Eventually(t.Up, 5*time.Second).Should(gomega.BeFalse())
+
+			gomega.Expect(get(t)).To(gomega.Equal("fake-response"))
+			gomega.Expect(t.Up()).To(gomega.BeTrue())
+			gomega.Expect(t.Reconnects()).To(gomega.Equal(uint64(1)))
+			// the host key is not scanned again
+			gomega.Expect(jumpbox.Connections()).To(gomega.Equal(3))
+		})
+	})
+
+	ginkgo.Context("when the private key is wrong", func() {
+		ginkgo.BeforeEach(func() {
+			gomega.Expect(os.WriteFile(privateKeyFile, fakebosh.NewJumpbox().PrivateKey(), 0600)).To(gomega.Succeed())
+		})
+
+		ginkgo.It("fails to connect", func() {
+			t, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+
+			_, err = get(t)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(t.Up()).To(gomega.BeFalse())
+		})
+	})
+
+	ginkgo.DescribeTable("invalid proxy URLs",
+		func(proxyURL string, message string) {
+			_, err := pool.Tunnel(proxyURL)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring(message))
+		},
+		ginkgo.Entry("wrong scheme", "socks5://jumpbox@10.0.0.5:22?private-key=key", "the scheme must be ssh+socks5"),
+		ginkgo.Entry("no port", "ssh+socks5://jumpbox@10.0.0.5?private-key=key", "the port is missing"),
+		ginkgo.Entry("no private key", "ssh+socks5://jumpbox@10.0.0.5:22", "the private-key query parameter is missing"),
+	)
+
+	ginkgo.It("exports the state of the tunnels", func() {
+		t, err := pool.Tunnel(proxyURL)
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		gomega.Expect(get(t)).To(gomega.Equal("fake-response"))
+
+		upMetric := prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				"test_exporter_ssh_tunnel_up",
+				"Whether the SSH connection of the proxy is established (1 for up, 0 for down).",
+				[]string{"proxy"},
+				nil,
+			),
+			prometheus.GaugeValue,
+			1,
+			t.Name(),
+		)
+		reconnectsMetric := prometheus.MustNewConstMetric(
+			prometheus.NewDesc(
+				"test_exporter_ssh_tunnel_reconnects_total",
+				"Total number of times the SSH connection of the proxy was re-established after it was lost.",
+				[]string{"proxy"},
+				nil,
+			),
+			prometheus.CounterValue,
+			0,
+			t.Name(),
+		)
+
+		metrics := make(chan prometheus.Metric, 2)
+		pool.Collect(metrics)
+		gomega.Expect(metrics).To(gomega.Receive(matchers.PrometheusMetric(upMetric)))
+		gomega.Expect(metrics).To(gomega.Receive(matchers.PrometheusMetric(reconnectsMetric)))
+	})
+})
@@ -0,0 +1,33 @@
+package ctxcall
+
+import (
+	"context"
+)
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+// Do runs call and returns its result, or the context error as soon as ctx is done. The BOSH CLI director
+// client does not take a context, so an abandoned call carries on in the background until the BOSH Director answers.
+func Do[T any](ctx context.Context, call func() (T, error)) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	results := make(chan result[T], 1)
+	go func() {
+		value, err := call()
+		results <- result[T]{value: value, err: err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,13 @@
+package ctxcall_test
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCtxCall(t *testing.T) {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "CtxCall Suite")
+}
@@ -0,0 +1,85 @@
+package ctxcall_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/cloudfoundry/bosh_exporter/utils/ctxcall"
+)
+
+var _ = ginkgo.Describe("Do", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		call   func() (string, error)
+		called bool
+
+		value string
+		err   error
+	)
+
+	ginkgo.BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		called = false
+		call = func() (string, error) {
+			called = true
+			return "fake-value", nil
+		}
+	})
+
+	ginkgo.AfterEach(func() {
+		cancel()
+	})
+
+	ginkgo.JustBeforeEach(func() {
+		value, err = ctxcall.Do(ctx, call)
+	})
+
+	ginkgo.It("returns the call result", func() {
+		gomega.Expect(value).To(gomega.Equal("fake-value"))
+		gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	})
+
+	ginkgo.Context("when the call fails", func() {
+		ginkgo.BeforeEach(func() {
+			call = func() (string, error) {
+				return "", errors.New("fake-error")
+			}
+		})
+
+		ginkgo.It("returns the call error", func() {
+			gomega.Expect(err).To(gomega.MatchError("fake-error"))
+		})
+	})
+
+	ginkgo.Context("when the context is already done", func() {
+		ginkgo.BeforeEach(func() {
+			cancel()
+		})
+
+		ginkgo.It("does not make the call", func() {
+			gomega.Expect(called).To(gomega.BeFalse())
+			gomega.Expect(err).To(gomega.MatchError(context.Canceled))
+		})
+	})
+
+	ginkgo.Context("when the context expires before the call returns", func() {
+		ginkgo.BeforeEach(func() {
+			cancel()
+			ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+			call = func() (string, error) {
+				time.Sleep(time.Second)
+				return "fake-value", nil
+			}
+		})
+
+		ginkgo.It("returns the context error without waiting for the call", func() {
+			gomega.Expect(value).To(gomega.BeEmpty())
+			gomega.Expect(err).To(gomega.MatchError(context.DeadlineExceeded))
+		})
+	})
+})
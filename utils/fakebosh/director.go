@@ -0,0 +1,264 @@
+// Package fakebosh provides a fake BOSH Director, and the stand-in UAA it delegates user management to, serving the
+// deployments, instances, releases and stemcells of its fixtures over HTTPS. Unlike the directorfakes of the BOSH CLI,
+// it exercises the real BOSH client, from authentication to the task flow used to list instances.
+package fakebosh
+
+import (
+	"embed"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Name and UUID are the name and UUID of the fake BOSH Director.
+	Name = "fake-director"
+	UUID = "fake-director-uuid"
+
+	// Version, CPI, StemcellOS and StemcellVersion describe the fake BOSH Director in its info.
+	Version         = "280.0.21 (00000000)"
+	CPI             = "warden_cpi"
+	StemcellOS      = "ubuntu-jammy"
+	StemcellVersion = "1.423"
+)
+
+//go:embed fixtures
+var fixtures embed.FS
+
+// task is a BOSH Director task listing the instances of a deployment, which is processing until it is polled.
+type task struct {
+	deploymentName string
+	polled         bool
+}
+
+// Director is a fake BOSH Director. Every endpoint but /info requires a token issued by its UAA.
+type Director struct {
+	server *httptest.Server
+	uaa    *UAA
+
+	mu          sync.Mutex
+	unavailable bool
+	latency     time.Duration
+	tasks       []*task
+	requests    map[string]int
+}
+
+// NewDirector starts a fake BOSH Director and its UAA. Close must be called to shut them down.
+func NewDirector() *Director {
+	d := &Director{
+		uaa:      newUAA(),
+		requests: map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /info", d.info)
+	mux.HandleFunc("GET /deployments", d.authorized(d.fixture("deployments.json")))
+	mux.HandleFunc("GET /deployments/{name}/instances", d.authorized(d.deploymentInstances))
+	mux.HandleFunc("GET /deployments/{name}/vms", d.authorized(d.deploymentInstances))
+	mux.HandleFunc("GET /tasks/{id}", d.authorized(d.task))
+	mux.HandleFunc("GET /tasks/{id}/output", d.authorized(d.taskOutput))
+	mux.HandleFunc("GET /releases/{name}", d.authorized(d.release))
+	mux.HandleFunc("GET /stemcells", d.authorized(d.fixture("stemcells.json")))
+	mux.HandleFunc("GET /tasks", d.authorized(d.emptyList))
+	mux.HandleFunc("GET /events", d.authorized(d.emptyList))
+	mux.HandleFunc("GET /disks", d.authorized(d.emptyList))
+	mux.HandleFunc("GET /orphaned_vms", d.authorized(d.emptyList))
+	mux.HandleFunc("GET /director/certificate_expiry", d.authorized(d.emptyList))
+	d.server = httptest.NewTLSServer(d.handle(mux))
+
+	return d
+}
+
+// URL returns the URL of the BOSH Director.
+func (d *Director) URL() string {
+	return d.server.URL
+}
+
+// UAA returns the UAA the BOSH Director delegates user management to.
+func (d *Director) UAA() *UAA {
+	return d.uaa
+}
+
+// CACert returns the PEM encoded certificate of the CA the BOSH Director and UAA certificates are signed with.
+func (d *Director) CACert() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: d.server.Certificate().Raw})
+}
+
+// Requests returns the number of requests received for a route pattern, e.g. "GET /deployments/{name}/instances".
+func (d *Director) Requests(pattern string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.requests[pattern]
+}
+
+// SetAvailable sets whether the BOSH Director answers requests, or fails them as a BOSH Director being upgraded does.
+func (d *Director) SetAvailable(available bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.unavailable = !available
+}
+
+// SetLatency sets how long the BOSH Director waits before answering every request, as a busy BOSH Director does.
+func (d *Director) SetLatency(latency time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.latency = latency
+}
+
+// Close shuts the BOSH Director and its UAA down.
+func (d *Director) Close() {
+	d.server.Close()
+	d.uaa.Close()
+}
+
+func (d *Director) handle(mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, pattern := mux.Handler(r)
+
+		d.mu.Lock()
+		d.requests[pattern]++
+		unavailable := d.unavailable
+		latency := d.latency
+		d.mu.Unlock()
+
+		time.Sleep(latency)
+		if unavailable {
+			http.Error(w, "502 Bad Gateway", http.StatusBadGateway)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (d *Director) authorized(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !d.uaa.authorized(r) {
+			http.Error(w, `{"code":600000,"description":"Require one of the scopes: bosh.admin"}`, http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func (d *Director) info(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, map[string]interface{}{
+		"name":    Name,
+		"uuid":    UUID,
+		"version": Version,
+		"cpi":     CPI,
+		"user_authentication": map[string]interface{}{
+			"type":    "uaa",
+			"options": map[string]interface{}{"url": d.uaa.URL()},
+		},
+		"features": map[string]interface{}{
+			"dns":       map[string]interface{}{"status": true},
+			"snapshots": map[string]interface{}{"status": false},
+		},
+		"stemcell_os":      StemcellOS,
+		"stemcell_version": StemcellVersion,
+	})
+}
+
+// deploymentInstances starts a task listing the instances of a deployment and redirects to it, as a BOSH Director does.
+func (d *Director) deploymentInstances(w http.ResponseWriter, r *http.Request) {
+	deploymentName := r.PathValue("name")
+	if _, err := fs.Stat(fixtures, "fixtures/"+instancesFixture(deploymentName)); err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	d.mu.Lock()
+	d.tasks = append(d.tasks, &task{deploymentName: deploymentName})
+	id := len(d.tasks)
+	d.mu.Unlock()
+
+	http.Redirect(w, r, fmt.Sprintf("/tasks/%d", id), http.StatusFound)
+}
+
+func (d *Director) task(w http.ResponseWriter, r *http.Request) {
+	id, t := d.findTask(r)
+	if t == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	d.mu.Lock()
+	state := "done"
+	if !t.polled {
+		state = "processing"
+		t.polled = true
+	}
+	d.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{"id": id, "state": state, "description": "retrieve vm-stats"})
+}
+
+func (d *Director) taskOutput(w http.ResponseWriter, r *http.Request) {
+	_, t := d.findTask(r)
+	if t == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.URL.Query().Get("type") != "result" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	d.fixture(instancesFixture(t.deploymentName))(w, r)
+}
+
+func (d *Director) findTask(r *http.Request) (int, *task) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return 0, nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if id < 1 || id > len(d.tasks) {
+		return 0, nil
+	}
+	return id, d.tasks[id-1]
+}
+
+func (d *Director) release(w http.ResponseWriter, r *http.Request) {
+	d.fixture(fmt.Sprintf("releases/%s-%s.json", r.PathValue("name"), r.URL.Query().Get("version")))(w, r)
+}
+
+func (d *Director) emptyList(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, []interface{}{})
+}
+
+func (d *Director) fixture(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		content, err := fixtures.ReadFile("fixtures/" + name)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(content)
+	}
+}
+
+func instancesFixture(deploymentName string) string {
+	return "instances/" + deploymentName + ".json"
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,192 @@
+package fakebosh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// JumpboxUsername is the user allowed to connect to the fake jumpbox.
+const JumpboxUsername = "jumpbox"
+
+// Jumpbox is a fake SSH server forwarding the connections of its user, as the jumpbox of a BOSH environment does.
+type Jumpbox struct {
+	listener   net.Listener
+	config     *ssh.ServerConfig
+	privateKey []byte
+
+	mu          sync.Mutex
+	conns       []*ssh.ServerConn
+	forwarded   int
+	connections int
+}
+
+// NewJumpbox starts a fake jumpbox, whose user authenticates with PrivateKey.
+func NewJumpbox() *Jumpbox {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		panic(err)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		panic(err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+
+	j := &Jumpbox{
+		listener:   listener,
+		privateKey: pem.EncodeToMemory(block),
+		config: &ssh.ServerConfig{
+			PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+				if conn.User() != JumpboxUsername || string(key.Marshal()) != string(signer.PublicKey().Marshal()) {
+					return nil, io.ErrUnexpectedEOF
+				}
+				return nil, nil
+			},
+		},
+	}
+	j.config.AddHostKey(signer)
+
+	go j.serve()
+
+	return j
+}
+
+// Addr returns the host:port address of the fake jumpbox.
+func (j *Jumpbox) Addr() string {
+	return j.listener.Addr().String()
+}
+
+// PrivateKey returns the PEM-encoded private key of the jumpbox user.
+func (j *Jumpbox) PrivateKey() []byte {
+	return j.privateKey
+}
+
+// Connections returns the number of SSH connections established with the fake jumpbox.
+func (j *Jumpbox) Connections() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.connections
+}
+
+// Forwarded returns the number of connections forwarded by the fake jumpbox.
+func (j *Jumpbox) Forwarded() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.forwarded
+}
+
+// DropConnections closes the SSH connections currently established, as a jumpbox restart would.
+func (j *Jumpbox) DropConnections() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for _, conn := range j.conns {
+		_ = conn.Close()
+	}
+	j.conns = nil
+}
+
+// Close stops the fake jumpbox.
+func (j *Jumpbox) Close() {
+	_ = j.listener.Close()
+	j.DropConnections()
+}
+
+func (j *Jumpbox) serve() {
+	for {
+		nConn, err := j.listener.Accept()
+		if err != nil {
+			return
+		}
+		go j.handle(nConn)
+	}
+}
+
+func (j *Jumpbox) handle(nConn net.Conn) {
+	conn, channels, requests, err := ssh.NewServerConn(nConn, j.config)
+	if err != nil {
+		_ = nConn.Close()
+		return
+	}
+
+	j.mu.Lock()
+	j.conns = append(j.conns, conn)
+	j.connections++
+	j.mu.Unlock()
+
+	go func() {
+		// keep-alive requests are answered too
+		for request := range requests {
+			if request.WantReply {
+				_ = request.Reply(true, nil)
+			}
+		}
+	}()
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		go j.forward(newChannel)
+	}
+}
+
+func (j *Jumpbox) forward(newChannel ssh.NewChannel) {
+	var target struct {
+		Host       string
+		Port       uint32
+		OriginHost string
+		OriginPort uint32
+	}
+	if err := ssh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+		_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+
+	targetConn, err := net.Dial("tcp", net.JoinHostPort(target.Host, strconv.Itoa(int(target.Port))))
+	if err != nil {
+		_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+		return
+	}
+	defer targetConn.Close()
+
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(requests)
+
+	j.mu.Lock()
+	j.forwarded++
+	j.mu.Unlock()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(targetConn, channel)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(channel, targetConn)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -0,0 +1,179 @@
+package fakebosh
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ClientID and ClientSecret are the credentials of the UAA client allowed to get a token with the client
+	// credentials grant.
+	ClientID     = "bosh_exporter"
+	ClientSecret = "fake-client-secret"
+
+	// Username and Password are the credentials of the UAA user allowed to get a token with the password grant.
+	Username = "admin"
+	Password = "fake-password"
+
+	// cliClientID is the UAA client used by the BOSH CLI for the password grant, which has no secret.
+	cliClientID = "bosh_cli"
+
+	tokenType     = "bearer"
+	tokenLifetime = time.Hour
+)
+
+// UAA is a stand-in for the UAA a BOSH Director delegates user management to. It issues tokens with the client
+// credentials, password and refresh token grants, and tells the Director which tokens it issued.
+type UAA struct {
+	server *httptest.Server
+
+	mu            sync.Mutex
+	accessTokens  map[string]bool
+	refreshTokens map[string]bool
+}
+
+func newUAA() *UAA {
+	u := &UAA{
+		accessTokens:  map[string]bool{},
+		refreshTokens: map[string]bool{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", u.token)
+	u.server = httptest.NewTLSServer(mux)
+
+	return u
+}
+
+// URL returns the URL of the UAA.
+func (u *UAA) URL() string {
+	return u.server.URL
+}
+
+// Close shuts the UAA down.
+func (u *UAA) Close() {
+	u.server.Close()
+}
+
+// RevokeTokens revokes every token issued so far, as a UAA does when they expire.
+func (u *UAA) RevokeTokens() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.accessTokens = map[string]bool{}
+	u.refreshTokens = map[string]bool{}
+}
+
+// RevokeAccessTokens revokes every access token issued so far, as a UAA does when they expire, but keeps the refresh
+// tokens valid.
+func (u *UAA) RevokeAccessTokens() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.accessTokens = map[string]bool{}
+}
+
+// authorized reports whether the Authorization header of a request holds a token issued by the UAA.
+func (u *UAA) authorized(r *http.Request) bool {
+	tokenType, value, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(tokenType, "bearer") {
+		return false
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.accessTokens[value]
+}
+
+func (u *UAA) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, _ := r.BasicAuth()
+
+	var refreshable bool
+	switch r.PostForm.Get("grant_type") {
+	case "client_credentials":
+		if clientID != ClientID || clientSecret != ClientSecret {
+			writeOAuthError(w, http.StatusUnauthorized, "unauthorized", "Bad credentials")
+			return
+		}
+	case "password":
+		if clientID != cliClientID || r.PostForm.Get("username") != Username || r.PostForm.Get("password") != Password {
+			writeOAuthError(w, http.StatusUnauthorized, "unauthorized", "Bad credentials")
+			return
+		}
+		refreshable = true
+	case "refresh_token":
+		u.mu.Lock()
+		valid := u.refreshTokens[r.PostForm.Get("refresh_token")]
+		u.mu.Unlock()
+		if clientID != cliClientID || !valid {
+			writeOAuthError(w, http.StatusUnauthorized, "invalid_token", "Invalid refresh token")
+			return
+		}
+		refreshable = true
+	default:
+		writeOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "Unsupported grant type")
+		return
+	}
+
+	resp := map[string]interface{}{
+		"token_type":   tokenType,
+		"access_token": newToken(),
+		"expires_in":   int(tokenLifetime.Seconds()),
+		"scope":        "bosh.admin",
+	}
+
+	u.mu.Lock()
+	u.accessTokens[resp["access_token"].(string)] = true
+	if refreshable {
+		resp["refresh_token"] = newToken()
+		u.refreshTokens[resp["refresh_token"].(string)] = true
+	}
+	u.mu.Unlock()
+
+	writeJSON(w, resp)
+}
+
+// newToken returns a random token shaped like a JWT, so that clients decoding its claims can read its expiry.
+func newToken() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"jti":   hex.EncodeToString(id),
+		"scope": []string{"bosh.admin"},
+		"exp":   time.Now().Add(tokenLifetime).Unix(),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	encoding := base64.RawURLEncoding
+	return fmt.Sprintf(
+		"%s.%s.%s",
+		encoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)),
+		encoding.EncodeToString(claims),
+		encoding.EncodeToString(id),
+	)
+}
+
+func writeOAuthError(w http.ResponseWriter, status int, code string, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code, "error_description": description})
+}
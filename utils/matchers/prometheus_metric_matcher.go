@@ -36,18 +36,18 @@ func (matcher *PrometheusMetricMatcher) Match(actual interface{}) (success bool,
 	_ = metric.Write(actualMetric)
 
 	if actualMetric.Counter != nil && matcher.Metric.Counter != nil {
-		actualMetric.Counter.CreatedTimestamp.Reset()
-		matcher.Metric.Counter.CreatedTimestamp.Reset()
+		actualMetric.Counter.CreatedTimestamp = nil
+		matcher.Metric.Counter.CreatedTimestamp = nil
 	}
 
 	if actualMetric.Histogram != nil && matcher.Metric.Histogram != nil {
-		actualMetric.Histogram.CreatedTimestamp.Reset()
-		matcher.Metric.Histogram.CreatedTimestamp.Reset()
+		actualMetric.Histogram.CreatedTimestamp = nil
+		matcher.Metric.Histogram.CreatedTimestamp = nil
 	}
 
 	if actualMetric.Summary != nil && matcher.Metric.Summary != nil {
-		actualMetric.Summary.CreatedTimestamp.Reset()
-		matcher.Metric.Summary.CreatedTimestamp.Reset()
+		actualMetric.Summary.CreatedTimestamp = nil
+		matcher.Metric.Summary.CreatedTimestamp = nil
 	}
 
 	if !reflect.DeepEqual(metric.Desc().String(), matcher.Desc.String()) {
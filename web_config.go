@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+
+	"go.yaml.in/yaml/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// deprecatedWebConfig is the web configuration file, in the exporter-toolkit format, equivalent to the deprecated
+// web.auth.* and web.tls.* flags.
+type deprecatedWebConfig struct {
+	TLSServerConfig *deprecatedTLSServerConfig `yaml:"tls_server_config,omitempty"`
+	BasicAuthUsers  map[string]string          `yaml:"basic_auth_users,omitempty"`
+}
+
+type deprecatedTLSServerConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+// deprecatedWebFlagsSet returns whether any of the deprecated web.auth.* and web.tls.* flags is set.
+func deprecatedWebFlagsSet() bool {
+	return *authUsername != "" || *authPassword != "" || *tlsCertFile != "" || *tlsKeyFile != ""
+}
+
+// writeDeprecatedWebConfig writes the web configuration file equivalent to the deprecated web.auth.* and web.tls.*
+// flags to a temporary file, and returns its name. As before the web.config.file flag, basic auth is only enabled when
+// both the username and password are set, and TLS when both the certificate and key files are set.
+func writeDeprecatedWebConfig() (string, error) {
+	var webConfig deprecatedWebConfig
+	if *tlsCertFile != "" && *tlsKeyFile != "" {
+		webConfig.TLSServerConfig = &deprecatedTLSServerConfig{CertFile: *tlsCertFile, KeyFile: *tlsKeyFile}
+	}
+	if *authUsername != "" && *authPassword != "" {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*authPassword), bcrypt.DefaultCost)
+		if err != nil {
+			return "", err
+		}
+		webConfig.BasicAuthUsers = map[string]string{*authUsername: string(hashedPassword)}
+	}
+
+	content, err := yaml.Marshal(webConfig)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp("", "bosh_exporter_web_config_*.yml")
+	if err != nil {
+		return "", err
+	}
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return "", err
+	}
+
+	return file.Name(), file.Close()
+}